@@ -0,0 +1,169 @@
+package cdk8skit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+const (
+	checksumAnnotation = "checksum/config"
+	skipChecksumType   = "cdk8skit:skip-checksum"
+)
+
+// SkipChecksum keeps the content of a Secret or ConfigMap out of the checksum
+// of the pods using it, so changing it does not roll them out.
+func SkipChecksum(object cdk8s.ApiObject) {
+	object.Node().AddMetadata(jsii.String(skipChecksumType), jsii.Bool(true), nil)
+}
+
+func skipsChecksum(object cdk8s.ApiObject) bool {
+	for _, entry := range *object.Node().Metadata() {
+		if *entry.Type == skipChecksumType {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup walks the rendered manifest along the keys.
+func lookup(value interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+func items(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+func addReference(references map[string]bool, kind string, name interface{}) {
+	if name, ok := name.(string); ok {
+		references[kind+"/"+name] = true
+	}
+}
+
+// podReferences lists the Secrets and ConfigMaps a rendered pod spec mounts
+// or reads variables from, as kind/name.
+func podReferences(spec interface{}) map[string]bool {
+
+	references := map[string]bool{}
+
+	for _, volume := range items(lookup(spec, "volumes")) {
+		addReference(references, "Secret", lookup(volume, "secret", "secretName"))
+		addReference(references, "ConfigMap", lookup(volume, "configMap", "name"))
+		for _, source := range items(lookup(volume, "projected", "sources")) {
+			addReference(references, "Secret", lookup(source, "secret", "name"))
+			addReference(references, "ConfigMap", lookup(source, "configMap", "name"))
+		}
+	}
+
+	containers := append(items(lookup(spec, "containers")), items(lookup(spec, "initContainers"))...)
+
+	for _, container := range containers {
+		for _, env := range items(lookup(container, "env")) {
+			addReference(references, "Secret", lookup(env, "valueFrom", "secretKeyRef", "name"))
+			addReference(references, "ConfigMap", lookup(env, "valueFrom", "configMapKeyRef", "name"))
+		}
+		for _, envFrom := range items(lookup(container, "envFrom")) {
+			addReference(references, "Secret", lookup(envFrom, "secretRef", "name"))
+			addReference(references, "ConfigMap", lookup(envFrom, "configMapRef", "name"))
+		}
+	}
+
+	return references
+}
+
+// contentChecksum hashes the content of the Secrets and ConfigMaps of the app
+// that a rendered pod spec uses. It is empty when the pod uses none of them.
+func contentChecksum(scope constructs.Construct, namespace *string, spec interface{}) string {
+
+	references := podReferences(spec)
+
+	contents := map[string]interface{}{}
+
+	for _, construct := range *scope.Node().Root().Node().FindAll(constructs.ConstructOrder_PREORDER) {
+		if !*cdk8s.ApiObject_IsApiObject(construct) {
+			continue
+		}
+		object := cdk8s.ApiObject_Of(construct)
+		key := *object.Kind() + "/" + *object.Name()
+		if !references[key] || !sameNamespace(object.Metadata().Namespace(), namespace) || skipsChecksum(object) {
+			continue
+		}
+		manifest := object.ToJson()
+		contents[key] = map[string]interface{}{
+			"type":       lookup(manifest, "type"),
+			"data":       lookup(manifest, "data"),
+			"stringData": lookup(manifest, "stringData"),
+			"binaryData": lookup(manifest, "binaryData"),
+		}
+	}
+
+	if len(contents) == 0 {
+		return ""
+	}
+
+	keys := []string{}
+	for key := range contents {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+
+	for _, key := range keys {
+		// json.Marshal сортирует ключи словарей, поэтому сумма не зависит от порядка
+		content, err := json.Marshal(contents[key])
+		if err != nil {
+			panic(err)
+		}
+		hash.Write([]byte(key))
+		hash.Write(content)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+type podChecksum struct {
+	pod  cdk8splus28.AbstractPod
+	path []string
+}
+
+// Validate is called right before rendering, once every Secret and ConfigMap
+// of the app exists, and annotates the pod template with their checksum.
+func (checksum *podChecksum) Validate() *[]*string {
+
+	apiObject := checksum.pod.ApiObject()
+
+	value := contentChecksum(apiObject, apiObject.Metadata().Namespace(), lookup(apiObject.ToJson(), checksum.path...))
+
+	if value != "" {
+		checksum.pod.PodMetadata().AddAnnotation(jsii.String(checksumAnnotation), jsii.String(value))
+	}
+
+	return &[]*string{}
+}
+
+// AttachChecksum annotates the template of the pod whose spec lives at the
+// given path with a hash of the Secrets and ConfigMaps it uses, so changing
+// their content rolls the pods out.
+func AttachChecksum(pod cdk8splus28.AbstractPod, path string) {
+	pod.Node().AddValidation(&podChecksum{
+		pod:  pod,
+		path: strings.Split(strings.Trim(path, "/"), "/"),
+	})
+}
@@ -0,0 +1,94 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+// renderChecksum renders a deployment mounting a secret and reading variables
+// from a config map, and returns its checksum annotation.
+func renderChecksum(t *testing.T, secretValue string, configValue string, skipSecret bool) interface{} {
+
+	app, chart := synth.NewChart("apps")
+
+	secret := cdk8splus28.NewSecret(chart, jsii.String("secret"), &cdk8splus28.SecretProps{
+		StringData: &map[string]*string{"token": jsii.String(secretValue)},
+	})
+	if skipSecret {
+		SkipChecksum(secret.ApiObject())
+	}
+
+	configMap := cdk8splus28.NewConfigMap(chart, jsii.String("config"), &cdk8splus28.ConfigMapProps{
+		Data: &map[string]*string{"LEVEL": jsii.String(configValue)},
+	})
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{})
+
+	container := deployment.AddContainer(&cdk8splus28.ContainerProps{
+		Image:   jsii.String("nginx"),
+		EnvFrom: &[]cdk8splus28.EnvFrom{cdk8splus28.Env_FromConfigMap(configMap, nil)},
+	})
+	container.Mount(jsii.String("/run/secrets"), cdk8splus28.Volume_FromSecret(chart, jsii.String("secret-volume"), secret, nil), nil)
+
+	AttachChecksum(deployment, "/spec/template/spec")
+
+	deploymentManifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	return synth.Field(deploymentManifest, "spec", "template", "metadata", "annotations", "checksum/config")
+}
+
+func TestChecksum(t *testing.T) {
+
+	checksum := renderChecksum(t, "a", "info", false)
+
+	if checksum == nil {
+		t.Fatal("expected a checksum annotation")
+	}
+	if again := renderChecksum(t, "a", "info", false); again != checksum {
+		t.Errorf("expected a stable checksum, got %v and %v", checksum, again)
+	}
+	if secretChanged := renderChecksum(t, "b", "info", false); secretChanged == checksum {
+		t.Error("expected the checksum to follow the secret")
+	}
+	if configChanged := renderChecksum(t, "a", "debug", false); configChanged == checksum {
+		t.Error("expected the checksum to follow the config map")
+	}
+}
+
+func TestSkipChecksum(t *testing.T) {
+
+	checksum := renderChecksum(t, "a", "info", true)
+
+	if checksum == nil {
+		t.Fatal("expected the config map to keep a checksum")
+	}
+	if secretChanged := renderChecksum(t, "b", "info", true); secretChanged != checksum {
+		t.Errorf("expected a skipped secret to keep the checksum, got %v and %v", checksum, secretChanged)
+	}
+}
+
+func TestChecksumWithoutReferences(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	cdk8splus28.NewSecret(chart, jsii.String("secret"), &cdk8splus28.SecretProps{
+		StringData: &map[string]*string{"token": jsii.String("a")},
+	})
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+		Containers: &[]*cdk8splus28.ContainerProps{
+			{Image: jsii.String("nginx")},
+		},
+	})
+
+	AttachChecksum(deployment, "/spec/template/spec")
+
+	deploymentManifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	if annotations := synth.Field(deploymentManifest, "spec", "template", "metadata", "annotations"); annotations != nil {
+		t.Errorf("expected no checksum for a pod without secrets, got %v", annotations)
+	}
+}
@@ -0,0 +1,130 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// ContainerProps describes an init or sidecar container running next to the
+// main container of a workload. A Native sidecar is rendered as an init
+// container with restartPolicy Always.
+type ContainerProps struct {
+	Name            *string
+	Image           *string
+	ImagePullPolicy cdk8splus28.ImagePullPolicy
+	Command         *[]*string
+	Args            *[]*string
+	Ports           *[]*Port
+	Variables       *map[*string]*string
+	Environment     *map[*string]*EnvSource
+	EnvFrom         *[]*EnvFromSource
+	Volumes         *map[*string]*cdk8splus28.Volume
+	Mounts          *map[*string]*Mount
+	Resources       *cdk8splus28.ContainerResources
+	Native          *bool
+}
+
+func (props *ContainerProps) defaultProps() {
+	if props.Ports == nil {
+		props.Ports = &[]*Port{}
+	}
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[*string]*cdk8splus28.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+	if props.Native == nil {
+		props.Native = jsii.Bool(false)
+	}
+}
+
+func (props *ContainerProps) containerProps() *cdk8splus28.ContainerProps {
+
+	ports := []*cdk8splus28.ContainerPort{}
+
+	for _, port := range *props.Ports {
+		port.defaultProps()
+		ports = append(ports, &cdk8splus28.ContainerPort{
+			Number:   port.ContainerPort,
+			Name:     port.Name,
+			Protocol: port.Protocol,
+		})
+	}
+
+	return &cdk8splus28.ContainerProps{
+		Name:            props.Name,
+		Image:           props.Image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		Ports:           &ports,
+		Resources:       props.Resources,
+		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
+			ReadOnlyRootFilesystem: jsii.Bool(false),
+			EnsureNonRoot:          jsii.Bool(false),
+		},
+	}
+}
+
+func (props *ContainerProps) configure(pod cdk8splus28.AbstractPod, container cdk8splus28.Container) {
+
+	for _, k := range SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
+	}
+
+	ConfigureEnv(pod, container, props.Environment, props.EnvFrom)
+
+	for _, path := range SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
+		container.Mount(path, storage, nil)
+	}
+
+	MountVolumes(container, props.Mounts)
+}
+
+// AttachContainers adds init and sidecar containers to a pod whose spec lives
+// at the given path of its api object. Native sidecars start ahead of the
+// regular init containers, so those can already rely on them.
+func AttachContainers(
+	pod cdk8splus28.AbstractPod,
+	path string,
+	initContainers *[]*ContainerProps,
+	sidecars *[]*ContainerProps,
+) {
+
+	index := 0
+
+	if sidecars != nil {
+		for _, props := range *sidecars {
+			props.defaultProps()
+
+			if !*props.Native {
+				props.configure(pod, pod.AddContainer(props.containerProps()))
+				continue
+			}
+
+			props.configure(pod, pod.AddInitContainer(props.containerProps()))
+
+			pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+				jsii.String(fmt.Sprintf("%s/initContainers/%d/restartPolicy", path, index)),
+				jsii.String("Always"),
+			))
+
+			index++
+		}
+	}
+
+	if initContainers != nil {
+		for _, props := range *initContainers {
+			props.defaultProps()
+			props.configure(pod, pod.AddInitContainer(props.containerProps()))
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// EnvSource references a variable value resolved when the pod starts: a key
+// of a Secret or ConfigMap, a pod field or a container resource.
+type EnvSource struct {
+	Secret    *string
+	ConfigMap *string
+	Key       *string
+	Field     cdk8splus28.EnvFieldPaths
+	Resource  cdk8splus28.ResourceFieldPaths
+	Divisor   *string
+	Optional  *bool
+}
+
+// EnvFromSource exposes every key of a Secret or ConfigMap as a variable.
+type EnvFromSource struct {
+	Secret    *string
+	ConfigMap *string
+	Prefix    *string
+}
+
+func EnvSource_FromSecret(name string, key string) *EnvSource {
+	return &EnvSource{Secret: jsii.String(name), Key: jsii.String(key)}
+}
+
+func EnvSource_FromConfigMap(name string, key string) *EnvSource {
+	return &EnvSource{ConfigMap: jsii.String(name), Key: jsii.String(key)}
+}
+
+func EnvSource_FromField(field cdk8splus28.EnvFieldPaths) *EnvSource {
+	return &EnvSource{Field: field}
+}
+
+func EnvSource_FromResource(resource cdk8splus28.ResourceFieldPaths) *EnvSource {
+	return &EnvSource{Resource: resource}
+}
+
+type envRefs struct {
+	pod        cdk8splus28.AbstractPod
+	container  string
+	secrets    map[string]cdk8splus28.ISecret
+	configMaps map[string]cdk8splus28.IConfigMap
+}
+
+func (refs *envRefs) secret(name *string) cdk8splus28.ISecret {
+	if secret, ok := refs.secrets[*name]; ok {
+		return secret
+	}
+	secret := cdk8splus28.Secret_FromSecretName(
+		refs.pod,
+		jsii.String(fmt.Sprintf("%s-env-secret-%s", refs.container, *name)),
+		name,
+	)
+	refs.secrets[*name] = secret
+	return secret
+}
+
+func (refs *envRefs) configMap(name *string) cdk8splus28.IConfigMap {
+	if configMap, ok := refs.configMaps[*name]; ok {
+		return configMap
+	}
+	configMap := cdk8splus28.ConfigMap_FromConfigMapName(
+		refs.pod,
+		jsii.String(fmt.Sprintf("%s-env-configmap-%s", refs.container, *name)),
+		name,
+	)
+	refs.configMaps[*name] = configMap
+	return configMap
+}
+
+func (refs *envRefs) value(name *string, source *EnvSource) cdk8splus28.EnvValue {
+
+	count := 0
+	for _, set := range []bool{source.Secret != nil, source.ConfigMap != nil, source.Field != "", source.Resource != ""} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		panic(fmt.Sprintf("Переменная %s должна ссылаться ровно на один источник", *name))
+	}
+	if (source.Secret != nil || source.ConfigMap != nil) && source.Key == nil {
+		panic(fmt.Sprintf("Для переменной %s не указан ключ", *name))
+	}
+
+	switch {
+	case source.Secret != nil:
+		return cdk8splus28.EnvValue_FromSecretValue(
+			&cdk8splus28.SecretValue{Secret: refs.secret(source.Secret), Key: source.Key},
+			&cdk8splus28.EnvValueFromSecretOptions{Optional: source.Optional},
+		)
+	case source.ConfigMap != nil:
+		return cdk8splus28.EnvValue_FromConfigMap(
+			refs.configMap(source.ConfigMap),
+			source.Key,
+			&cdk8splus28.EnvValueFromConfigMapOptions{Optional: source.Optional},
+		)
+	case source.Field != "":
+		return cdk8splus28.EnvValue_FromFieldRef(
+			source.Field,
+			&cdk8splus28.EnvValueFromFieldRefOptions{Key: source.Key},
+		)
+	default:
+		return cdk8splus28.EnvValue_FromResource(
+			source.Resource,
+			&cdk8splus28.EnvValueFromResourceOptions{Divisor: source.Divisor},
+		)
+	}
+}
+
+// ConfigureEnv adds referenced variables and envFrom sources to a container
+// of the pod. Imported Secrets and ConfigMaps are scoped to the pod.
+func ConfigureEnv(
+	pod cdk8splus28.AbstractPod,
+	container cdk8splus28.Container,
+	environment *map[*string]*EnvSource,
+	envFrom *[]*EnvFromSource,
+) {
+
+	refs := &envRefs{
+		pod:        pod,
+		container:  *container.Name(),
+		secrets:    map[string]cdk8splus28.ISecret{},
+		configMaps: map[string]cdk8splus28.IConfigMap{},
+	}
+
+	if environment != nil {
+		for _, name := range SortedKeys(*environment) {
+			container.Env().AddVariable(name, refs.value(name, (*environment)[name]))
+		}
+	}
+
+	if envFrom != nil {
+		for _, source := range *envFrom {
+			if (source.Secret == nil) == (source.ConfigMap == nil) {
+				panic("Источник envFrom должен ссылаться либо на Secret, либо на ConfigMap")
+			}
+			if source.Secret != nil {
+				container.Env().CopyFrom(cdk8splus28.NewEnvFrom(nil, source.Prefix, refs.secret(source.Secret)))
+			} else {
+				container.Env().CopyFrom(cdk8splus28.NewEnvFrom(refs.configMap(source.ConfigMap), source.Prefix, nil))
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestConfigureEnv(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{})
+	container := deployment.AddContainer(&cdk8splus28.ContainerProps{
+		Name:  jsii.String("api"),
+		Image: jsii.String("api:1.0"),
+	})
+
+	ConfigureEnv(deployment, container, &map[*string]*EnvSource{
+		jsii.String("DB_PASSWORD"): EnvSource_FromSecret("db", "password"),
+		jsii.String("LOG_LEVEL"):   EnvSource_FromConfigMap("settings", "level"),
+		jsii.String("POD_NAME"):    EnvSource_FromField(cdk8splus28.EnvFieldPaths_POD_NAME),
+		jsii.String("CPU_LIMIT"):   EnvSource_FromResource(cdk8splus28.ResourceFieldPaths_CPU_LIMIT),
+	}, &[]*EnvFromSource{
+		{ConfigMap: jsii.String("settings"), Prefix: jsii.String("APP_")},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	env := map[string]interface{}{}
+	for _, variable := range synth.Field(spec, "env").([]interface{}) {
+		env[synth.Field(variable, "name").(string)] = synth.Field(variable, "valueFrom")
+	}
+
+	expected := map[string][]interface{}{
+		"DB_PASSWORD": {"secretKeyRef", "name", "db"},
+		"LOG_LEVEL":   {"configMapKeyRef", "key", "level"},
+		"POD_NAME":    {"fieldRef", "fieldPath", "metadata.name"},
+		"CPU_LIMIT":   {"resourceFieldRef", "resource", "limits.cpu"},
+	}
+
+	for name, path := range expected {
+		if value := synth.Field(env[name], path[:2]...); value != path[2] {
+			t.Errorf("%s: expected %v, got %v", name, path[2], value)
+		}
+	}
+
+	if name := synth.Field(spec, "envFrom", 0, "configMapRef", "name"); name != "settings" {
+		t.Errorf("expected the settings ConfigMap, got %v", name)
+	}
+	if prefix := synth.Field(spec, "envFrom", 0, "prefix"); prefix != "APP_" {
+		t.Errorf("expected the APP_ prefix, got %v", prefix)
+	}
+}
+
+func TestConfigureEnvRequiresOneSource(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{})
+	container := deployment.AddContainer(&cdk8splus28.ContainerProps{
+		Name:  jsii.String("api"),
+		Image: jsii.String("api:1.0"),
+	})
+
+	if synth.Panics(func() {
+		ConfigureEnv(deployment, container, &map[*string]*EnvSource{
+			jsii.String("TOKEN"): {Secret: jsii.String("token"), ConfigMap: jsii.String("settings"), Key: jsii.String("token")},
+		}, nil)
+	}) == nil {
+		t.Error("expected a panic for a variable with two sources")
+	}
+}
@@ -0,0 +1,84 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/jsii-runtime-go"
+)
+
+const defaultRegistry = "docker.io"
+
+// Image is a reference to a container image. Registry is docker.io when nil,
+// Digest pins the image over the Tag.
+type Image struct {
+	Registry   *string
+	Repository *string
+	Tag        *string
+	Digest     *string
+}
+
+// Image_Parse splits an image name such as
+// registry.example.com:5000/team/app:1.2@sha256:... into its parts.
+func Image_Parse(name string) *Image {
+
+	image := &Image{}
+
+	if at := strings.Index(name, "@"); at >= 0 {
+		image.Digest = jsii.String(name[at+1:])
+		name = name[:at]
+	}
+
+	// Двоеточие после последнего слэша отделяет тег, а не порт реестра
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		image.Tag = jsii.String(name[colon+1:])
+		name = name[:colon]
+	}
+
+	if slash := strings.Index(name, "/"); slash >= 0 {
+		host := name[:slash]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			image.Registry = jsii.String(host)
+			name = name[slash+1:]
+		}
+	}
+
+	image.Repository = jsii.String(name)
+
+	return image
+}
+
+// Name renders the reference to put into a container spec, so a structured
+// image can be passed wherever the kit takes an image name.
+func (image *Image) Name() *string {
+
+	if image.Repository == nil {
+		panic("Не указан репозиторий образа")
+	}
+
+	name := *image.Repository
+
+	if image.Registry != nil {
+		name = fmt.Sprintf("%s/%s", *image.Registry, name)
+	}
+	if image.Tag != nil {
+		name = fmt.Sprintf("%s:%s", name, *image.Tag)
+	}
+	if image.Digest != nil {
+		name = fmt.Sprintf("%s@%s", name, *image.Digest)
+	}
+
+	return jsii.String(name)
+}
+
+// String renders the reference, see Name.
+func (image *Image) String() string {
+	return *image.Name()
+}
+
+func (image *Image) registry() string {
+	if image.Registry == nil {
+		return defaultRegistry
+	}
+	return *image.Registry
+}
@@ -0,0 +1,47 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+)
+
+func TestImageParse(t *testing.T) {
+
+	names := []string{
+		"nginx",
+		"nginx:1.25",
+		"team/app:1.2",
+		"localhost/app",
+		"registry.example.com:5000/team/app:1.2@sha256:abc",
+	}
+
+	for _, name := range names {
+		if rendered := Image_Parse(name).String(); rendered != name {
+			t.Errorf("expected %s to render back, got %s", name, rendered)
+		}
+	}
+
+	image := Image_Parse("registry.example.com:5000/team/app:1.2")
+
+	if *image.Registry != "registry.example.com:5000" || *image.Repository != "team/app" || *image.Tag != "1.2" {
+		t.Errorf("unexpected parts %s %s %s", *image.Registry, *image.Repository, *image.Tag)
+	}
+}
+
+func TestImageString(t *testing.T) {
+
+	image := &Image{
+		Registry:   jsii.String("registry.example.com"),
+		Repository: jsii.String("team/app"),
+		Tag:        jsii.String("1.2"),
+	}
+
+	if rendered := fmt.Sprint(image); rendered != "registry.example.com/team/app:1.2" {
+		t.Errorf("unexpected image %s", rendered)
+	}
+	if *image.Name() != image.String() {
+		t.Errorf("expected Name and String to agree, got %s and %s", *image.Name(), image.String())
+	}
+}
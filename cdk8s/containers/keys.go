@@ -0,0 +1,21 @@
+package cdk8skit
+
+import "sort"
+
+// SortedKeys returns the keys of the map ordered by their values. Go maps
+// iterate in a random order, and rendering them as is would change the pod
+// template, and roll the pods out, on every synth.
+func SortedKeys[T any](values map[*string]T) []*string {
+
+	keys := make([]*string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return *keys[i] < *keys[j]
+	})
+
+	return keys
+}
@@ -0,0 +1,51 @@
+package cdk8skit
+
+import (
+	"strconv"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// Handler_FromSleep pauses the container for the given duration, typically as
+// a preStop hook letting in-flight requests drain before SIGTERM.
+func Handler_FromSleep(duration cdk8s.Duration) cdk8splus28.Handler {
+
+	// Действие sleep появилось в Kubernetes 1.29 и отсутствует в схеме 1.28,
+	// поэтому пауза выполняется командой, которая должна быть в образе
+	seconds := strconv.FormatFloat(*duration.ToSeconds(nil), 'f', -1, 64)
+
+	return cdk8splus28.Handler_FromCommand(&[]*string{
+		jsii.String("sleep"),
+		jsii.String(seconds),
+	})
+}
+
+// Lifecycle returns the lifecycle of a container, or nil without hooks.
+func Lifecycle(postStart cdk8splus28.Handler, preStop cdk8splus28.Handler) *cdk8splus28.ContainerLifecycle {
+
+	if postStart == nil && preStop == nil {
+		return nil
+	}
+
+	return &cdk8splus28.ContainerLifecycle{
+		PostStart: postStart,
+		PreStop:   preStop,
+	}
+}
+
+// PatchTerminationMessagePolicy sets the termination message policy, File or
+// FallbackToLogsOnError, of the main container of the pod spec at the given
+// path. cdk8s-plus does not expose it.
+func PatchTerminationMessagePolicy(apiObject cdk8s.ApiObject, path string, policy *string) {
+
+	if policy == nil {
+		return
+	}
+
+	apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String(path+"/containers/0/terminationMessagePolicy"),
+		policy,
+	))
+}
@@ -0,0 +1,29 @@
+package cdk8skit
+
+import (
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// Mount places a volume, or a single file of it when SubPath is set, at a
+// path of the container.
+type Mount struct {
+	Volume   *cdk8splus28.Volume
+	SubPath  *string
+	ReadOnly *bool
+}
+
+func MountVolumes(container cdk8splus28.Container, mounts *map[*string]*Mount) {
+
+	if mounts == nil {
+		return
+	}
+
+	for _, path := range SortedKeys(*mounts) {
+		mount := (*mounts)[path]
+		var storage cdk8splus28.IStorage = *mount.Volume
+		container.Mount(path, storage, &cdk8splus28.MountOptions{
+			SubPath:  mount.SubPath,
+			ReadOnly: mount.ReadOnly,
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// Port maps a container port to a Service port. The first port of a list is
+// the primary one: probes and ingress routes target it unless told otherwise.
+type Port struct {
+	Name          *string
+	Port          *float64
+	ContainerPort *float64
+	Protocol      cdk8splus28.Protocol
+	AppProtocol   *string
+}
+
+func (port *Port) defaultProps() {
+	if port.Port == nil && port.ContainerPort == nil {
+		panic("Не указан номер порта")
+	}
+	if port.Port == nil {
+		port.Port = port.ContainerPort
+	}
+	if port.ContainerPort == nil {
+		port.ContainerPort = port.Port
+	}
+}
+
+// ServiceName is the Service port name, the port number when no name is set.
+// Without a Port the Service port takes the number of the container port.
+func (port *Port) ServiceName() *string {
+	port.defaultProps()
+	if port.Name != nil {
+		return port.Name
+	}
+	return jsii.String(fmt.Sprintf("%d", int(*port.Port)))
+}
+
+func ServicePorts(ports []*Port) *[]*cdk8splus28.ServicePort {
+
+	servicePorts := []*cdk8splus28.ServicePort{}
+
+	for _, port := range ports {
+		port.defaultProps()
+		servicePorts = append(servicePorts, &cdk8splus28.ServicePort{
+			Name:       port.ServiceName(),
+			Port:       port.Port,
+			TargetPort: port.ContainerPort,
+			Protocol:   port.Protocol,
+		})
+	}
+
+	return &servicePorts
+}
+
+// AdditionalContainerPorts returns every port but the primary one, which
+// cdk8s-plus already declares from the container PortNumber.
+func AdditionalContainerPorts(ports []*Port) *[]*cdk8splus28.ContainerPort {
+
+	containerPorts := []*cdk8splus28.ContainerPort{}
+
+	for i, port := range ports {
+		port.defaultProps()
+		if i == 0 {
+			continue
+		}
+		containerPorts = append(containerPorts, &cdk8splus28.ContainerPort{
+			Number:   port.ContainerPort,
+			Name:     port.Name,
+			Protocol: port.Protocol,
+		})
+	}
+
+	return &containerPorts
+}
+
+// PatchPrimaryContainerPort adds the name and protocol of the primary port to
+// the container port cdk8s-plus renders at the given path.
+func PatchPrimaryContainerPort(apiObject cdk8s.ApiObject, path string, ports []*Port) {
+
+	port := ports[0]
+	port.defaultProps()
+
+	if port.Name != nil {
+		apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String(fmt.Sprintf("%s/name", path)), port.Name))
+	}
+	if port.Protocol != "" {
+		apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String(fmt.Sprintf("%s/protocol", path)), port.Protocol))
+	}
+}
+
+// PatchAppProtocols sets appProtocol on the Service ports, which cdk8s-plus does not model.
+func PatchAppProtocols(service cdk8splus28.Service, ports []*Port) {
+	for i, port := range ports {
+		if port.AppProtocol != nil {
+			service.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+				jsii.String(fmt.Sprintf("/spec/ports/%d/appProtocol", i)),
+				port.AppProtocol,
+			))
+		}
+	}
+}
+
+// FindPort looks a port up by its Service port name and falls back to the primary port.
+func FindPort(ports []*Port, name *string) *Port {
+	if name != nil {
+		for _, port := range ports {
+			if *port.ServiceName() == *name {
+				return port
+			}
+		}
+		panic(fmt.Sprintf("Порт %s не найден", *name))
+	}
+	return ports[0]
+}
@@ -0,0 +1,137 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// ProbeOptions is shared by the probe shorthands. When Port is nil the probe
+// targets the container port of the workload it is attached to.
+type ProbeOptions struct {
+	Port                *float64
+	FailureThreshold    *float64
+	SuccessThreshold    *float64
+	InitialDelaySeconds cdk8s.Duration
+	PeriodSeconds       cdk8s.Duration
+	TimeoutSeconds      cdk8s.Duration
+}
+
+func (options *ProbeOptions) defaultProps() {
+	if options.FailureThreshold == nil {
+		options.FailureThreshold = jsii.Number(3)
+	}
+	if options.PeriodSeconds == nil {
+		options.PeriodSeconds = cdk8s.Duration_Seconds(jsii.Number(10))
+	}
+	if options.TimeoutSeconds == nil {
+		options.TimeoutSeconds = cdk8s.Duration_Seconds(jsii.Number(1))
+	}
+}
+
+// GrpcProbe is a gRPC health check. cdk8s-plus has no gRPC probe, so the
+// workload constructs render it into the pod template themselves.
+type GrpcProbe struct {
+	Service *string
+	Options ProbeOptions
+}
+
+func Probe_FromHttpPath(path *string, options *ProbeOptions) cdk8splus28.Probe {
+
+	options.defaultProps()
+
+	return cdk8splus28.Probe_FromHttpGet(path, &cdk8splus28.HttpGetProbeOptions{
+		Port:                options.Port,
+		FailureThreshold:    options.FailureThreshold,
+		SuccessThreshold:    options.SuccessThreshold,
+		InitialDelaySeconds: options.InitialDelaySeconds,
+		PeriodSeconds:       options.PeriodSeconds,
+		TimeoutSeconds:      options.TimeoutSeconds,
+	})
+}
+
+func Probe_FromTcp(options *ProbeOptions) cdk8splus28.Probe {
+
+	options.defaultProps()
+
+	return cdk8splus28.Probe_FromTcpSocket(&cdk8splus28.TcpSocketProbeOptions{
+		Port:                options.Port,
+		FailureThreshold:    options.FailureThreshold,
+		SuccessThreshold:    options.SuccessThreshold,
+		InitialDelaySeconds: options.InitialDelaySeconds,
+		PeriodSeconds:       options.PeriodSeconds,
+		TimeoutSeconds:      options.TimeoutSeconds,
+	})
+}
+
+func Probe_FromCommand(command *[]*string, options *ProbeOptions) cdk8splus28.Probe {
+
+	options.defaultProps()
+
+	return cdk8splus28.Probe_FromCommand(command, &cdk8splus28.CommandProbeOptions{
+		FailureThreshold:    options.FailureThreshold,
+		SuccessThreshold:    options.SuccessThreshold,
+		InitialDelaySeconds: options.InitialDelaySeconds,
+		PeriodSeconds:       options.PeriodSeconds,
+		TimeoutSeconds:      options.TimeoutSeconds,
+	})
+}
+
+func Probe_FromGrpc(service *string, options *ProbeOptions) cdk8splus28.Probe {
+
+	options.defaultProps()
+
+	return &GrpcProbe{
+		Service: service,
+		Options: *options,
+	}
+}
+
+// NativeProbe returns the probe to hand over to cdk8s-plus, or nil for a GrpcProbe.
+func NativeProbe(probe cdk8splus28.Probe) cdk8splus28.Probe {
+	if _, ok := probe.(*GrpcProbe); ok {
+		return nil
+	}
+	return probe
+}
+
+// PatchGrpcProbe writes a GrpcProbe to the given path of the api object.
+// Other probes are left to cdk8s-plus. Without a container port the probe
+// must set its own.
+func PatchGrpcProbe(apiObject cdk8s.ApiObject, path string, probe cdk8splus28.Probe, port *float64) {
+
+	grpc, ok := probe.(*GrpcProbe)
+	if !ok {
+		return
+	}
+
+	if grpc.Options.Port != nil {
+		port = grpc.Options.Port
+	}
+
+	if port == nil {
+		panic("Не указан порт gRPC пробы")
+	}
+
+	action := map[string]interface{}{
+		"port": port,
+	}
+	if grpc.Service != nil {
+		action["service"] = grpc.Service
+	}
+
+	value := map[string]interface{}{
+		"grpc":             &action,
+		"failureThreshold": grpc.Options.FailureThreshold,
+		"periodSeconds":    grpc.Options.PeriodSeconds.ToSeconds(nil),
+		"timeoutSeconds":   grpc.Options.TimeoutSeconds.ToSeconds(nil),
+	}
+	if grpc.Options.SuccessThreshold != nil {
+		value["successThreshold"] = grpc.Options.SuccessThreshold
+	}
+	if grpc.Options.InitialDelaySeconds != nil {
+		value["initialDelaySeconds"] = grpc.Options.InitialDelaySeconds.ToSeconds(nil)
+	}
+
+	apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String(path), &value))
+}
@@ -0,0 +1,107 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+const livenessPath = "/spec/template/spec/containers/0/livenessProbe"
+
+func newProbedDeployment(probe cdk8splus28.Probe) (cdk8s.App, cdk8splus28.Deployment) {
+
+	app, chart := synth.NewChart("")
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+		Containers: &[]*cdk8splus28.ContainerProps{
+			{
+				Image:      jsii.String("nginx"),
+				PortNumber: jsii.Number(8080),
+				Liveness:   NativeProbe(probe),
+			},
+		},
+	})
+
+	return app, deployment
+}
+
+func TestPatchGrpcProbe(t *testing.T) {
+
+	probe := Probe_FromGrpc(jsii.String("health"), &ProbeOptions{
+		InitialDelaySeconds: cdk8s.Duration_Seconds(jsii.Number(5)),
+	})
+
+	app, deployment := newProbedDeployment(probe)
+
+	PatchGrpcProbe(deployment.ApiObject(), livenessPath, probe, jsii.Number(8080))
+
+	liveness := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0, "livenessProbe")
+
+	if port := synth.Field(liveness, "grpc", "port"); port != float64(8080) {
+		t.Errorf("expected the container port 8080, got %v", port)
+	}
+	if service := synth.Field(liveness, "grpc", "service"); service != "health" {
+		t.Errorf("expected the health service, got %v", service)
+	}
+
+	expected := map[string]interface{}{
+		"failureThreshold":    float64(3),
+		"periodSeconds":       float64(10),
+		"timeoutSeconds":      float64(1),
+		"initialDelaySeconds": float64(5),
+	}
+
+	for key, value := range expected {
+		if actual := synth.Field(liveness, key); actual != value {
+			t.Errorf("%s: expected %v, got %v", key, value, actual)
+		}
+	}
+}
+
+func TestPatchGrpcProbeExplicitPort(t *testing.T) {
+
+	probe := Probe_FromGrpc(nil, &ProbeOptions{
+		Port: jsii.Number(9000),
+	})
+
+	app, deployment := newProbedDeployment(probe)
+
+	PatchGrpcProbe(deployment.ApiObject(), livenessPath, probe, jsii.Number(8080))
+
+	deploymentManifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	if port := synth.Field(deploymentManifest, "spec", "template", "spec", "containers", 0, "livenessProbe", "grpc", "port"); port != float64(9000) {
+		t.Errorf("expected the probe port 9000, got %v", port)
+	}
+}
+
+func TestPatchGrpcProbeKeepsOtherProbes(t *testing.T) {
+
+	probe := Probe_FromTcp(&ProbeOptions{})
+
+	app, deployment := newProbedDeployment(probe)
+
+	PatchGrpcProbe(deployment.ApiObject(), livenessPath, probe, jsii.Number(8080))
+
+	liveness := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0, "livenessProbe")
+
+	if synth.Field(liveness, "grpc") != nil || synth.Field(liveness, "tcpSocket") == nil {
+		t.Errorf("expected the TCP probe rendered by cdk8s-plus, got %v", liveness)
+	}
+}
+
+func TestPatchGrpcProbeWithoutPort(t *testing.T) {
+
+	probe := Probe_FromGrpc(nil, &ProbeOptions{})
+
+	_, deployment := newProbedDeployment(probe)
+
+	if synth.Panics(func() {
+		PatchGrpcProbe(deployment.ApiObject(), livenessPath, probe, nil)
+	}) == nil {
+		t.Error("expected a panic for a gRPC probe without a port")
+	}
+}
@@ -0,0 +1,73 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestPullSecrets(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+		Containers: &[]*cdk8splus28.ContainerProps{
+			{Image: jsii.String("registry.example.com:5000/team/api:1.0")},
+			{Image: jsii.String("nginx")},
+		},
+	})
+
+	AttachPullSecrets(deployment, "/spec/template/spec")
+
+	// Секрет создаётся после рабочей нагрузки и всё равно должен попасть в неё
+	secret := NewRegistrySecret(chart, "registry", &RegistrySecretProps{
+		Registry: jsii.String("registry.example.com:5000"),
+		Username: jsii.String("user"),
+		Password: jsii.String("password"),
+	})
+
+	app.SynthYaml()
+
+	manifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	references := synth.Field(manifest, "spec", "template", "spec", "imagePullSecrets")
+
+	if count := len(references.([]interface{})); count != 1 {
+		t.Fatalf("expected one pull secret, got %v", references)
+	}
+	if name := synth.Field(references, 0, "name"); name != *secret.Name() {
+		t.Errorf("expected the registry pull secret %s, got %v", *secret.Name(), name)
+	}
+}
+
+func TestPullSecretsOtherNamespace(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	deployment := cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+		Containers: &[]*cdk8splus28.ContainerProps{
+			{Image: jsii.String("registry.example.com/team/api:1.0")},
+		},
+	})
+
+	AttachPullSecrets(deployment, "/spec/template/spec")
+
+	other := cdk8s.NewChart(app, jsii.String("other"), &cdk8s.ChartProps{
+		Namespace: jsii.String("other"),
+	})
+
+	NewRegistrySecret(other, "registry", &RegistrySecretProps{
+		Registry: jsii.String("registry.example.com"),
+		Username: jsii.String("user"),
+		Password: jsii.String("password"),
+	})
+
+	manifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	if references := synth.Field(manifest, "spec", "template", "spec", "imagePullSecrets"); references != nil {
+		t.Errorf("expected no pull secrets from another namespace, got %v", references)
+	}
+}
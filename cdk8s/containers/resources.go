@@ -0,0 +1,88 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// ResourcesProps describes container requests and limits.
+// CPU is expressed in millicores, memory in mebibytes and ephemeral storage in gibibytes.
+type ResourcesProps struct {
+	CpuRequest              *float64
+	CpuLimit                *float64
+	MemoryRequest           *float64
+	MemoryLimit             *float64
+	EphemeralStorageRequest *float64
+	EphemeralStorageLimit   *float64
+}
+
+func NewResources(props *ResourcesProps) *cdk8splus28.ContainerResources {
+
+	resources := &cdk8splus28.ContainerResources{}
+
+	if props.CpuRequest != nil || props.CpuLimit != nil {
+		resources.Cpu = &cdk8splus28.CpuResources{}
+		if props.CpuRequest != nil {
+			resources.Cpu.Request = cdk8splus28.Cpu_Millis(props.CpuRequest)
+		}
+		if props.CpuLimit != nil {
+			resources.Cpu.Limit = cdk8splus28.Cpu_Millis(props.CpuLimit)
+		}
+	}
+
+	if props.MemoryRequest != nil || props.MemoryLimit != nil {
+		resources.Memory = &cdk8splus28.MemoryResources{}
+		if props.MemoryRequest != nil {
+			resources.Memory.Request = cdk8s.Size_Mebibytes(props.MemoryRequest)
+		}
+		if props.MemoryLimit != nil {
+			resources.Memory.Limit = cdk8s.Size_Mebibytes(props.MemoryLimit)
+		}
+	}
+
+	if props.EphemeralStorageRequest != nil || props.EphemeralStorageLimit != nil {
+		resources.EphemeralStorage = &cdk8splus28.EphemeralStorageResources{}
+		if props.EphemeralStorageRequest != nil {
+			resources.EphemeralStorage.Request = cdk8s.Size_Gibibytes(props.EphemeralStorageRequest)
+		}
+		if props.EphemeralStorageLimit != nil {
+			resources.EphemeralStorage.Limit = cdk8s.Size_Gibibytes(props.EphemeralStorageLimit)
+		}
+	}
+
+	return resources
+}
+
+func Resources_Small() *cdk8splus28.ContainerResources {
+	return NewResources(&ResourcesProps{
+		CpuRequest:              jsii.Number(100),
+		CpuLimit:                jsii.Number(250),
+		MemoryRequest:           jsii.Number(128),
+		MemoryLimit:             jsii.Number(256),
+		EphemeralStorageRequest: jsii.Number(1),
+		EphemeralStorageLimit:   jsii.Number(2),
+	})
+}
+
+func Resources_Medium() *cdk8splus28.ContainerResources {
+	return NewResources(&ResourcesProps{
+		CpuRequest:              jsii.Number(250),
+		CpuLimit:                jsii.Number(500),
+		MemoryRequest:           jsii.Number(256),
+		MemoryLimit:             jsii.Number(512),
+		EphemeralStorageRequest: jsii.Number(2),
+		EphemeralStorageLimit:   jsii.Number(4),
+	})
+}
+
+func Resources_Large() *cdk8splus28.ContainerResources {
+	return NewResources(&ResourcesProps{
+		CpuRequest:              jsii.Number(500),
+		CpuLimit:                jsii.Number(1000),
+		MemoryRequest:           jsii.Number(512),
+		MemoryLimit:             jsii.Number(1024),
+		EphemeralStorageRequest: jsii.Number(4),
+		EphemeralStorageLimit:   jsii.Number(8),
+	})
+}
@@ -0,0 +1,57 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestResourcePresets(t *testing.T) {
+
+	presets := map[string]*cdk8splus28.ContainerResources{
+		"small":  Resources_Small(),
+		"medium": Resources_Medium(),
+		"large":  Resources_Large(),
+	}
+
+	expected := map[string]map[string]map[string]string{
+		"small": {
+			"requests": {"cpu": "100m", "memory": "128Mi", "ephemeral-storage": "1Gi"},
+			"limits":   {"cpu": "250m", "memory": "256Mi", "ephemeral-storage": "2Gi"},
+		},
+		"medium": {
+			"requests": {"cpu": "250m", "memory": "256Mi", "ephemeral-storage": "2Gi"},
+			"limits":   {"cpu": "500m", "memory": "512Mi", "ephemeral-storage": "4Gi"},
+		},
+		"large": {
+			"requests": {"cpu": "500m", "memory": "512Mi", "ephemeral-storage": "4Gi"},
+			"limits":   {"cpu": "1000m", "memory": "1024Mi", "ephemeral-storage": "8Gi"},
+		},
+	}
+
+	for name, resources := range presets {
+		app, chart := synth.NewChart("")
+
+		cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+			Containers: &[]*cdk8splus28.ContainerProps{
+				{
+					Image:     jsii.String("nginx"),
+					Resources: resources,
+				},
+			},
+		})
+
+		deployment := synth.Find(t, synth.Manifests(app), "Deployment")
+
+		for kind, quantities := range expected[name] {
+			for resource, quantity := range quantities {
+				actual := synth.Field(deployment, "spec", "template", "spec", "containers", 0, "resources", kind, resource)
+				if actual != quantity {
+					t.Errorf("%s %s %s: expected %s, got %v", name, kind, resource, quantity, actual)
+				}
+			}
+		}
+	}
+}
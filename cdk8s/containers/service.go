@@ -0,0 +1,94 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+type ServiceType string
+
+const (
+	ServiceType_CLUSTER_IP    ServiceType = "CLUSTER_IP"
+	ServiceType_NODE_PORT     ServiceType = "NODE_PORT"
+	ServiceType_LOAD_BALANCER ServiceType = "LOAD_BALANCER"
+	// A headless Service: no cluster IP, its DNS name resolves to the pods.
+	ServiceType_NONE ServiceType = "NONE"
+)
+
+// ServiceOptions describes how a workload is exposed, as a ClusterIP Service
+// by default. NodePort fixes the node port of the primary port.
+type ServiceOptions struct {
+	Type                     ServiceType
+	NodePort                 *float64
+	LoadBalancerSourceRanges *[]*string
+	Annotations              *map[string]*string
+	ExternalTrafficPolicy    *string
+	SessionAffinity          *string
+	SessionAffinityTimeout   cdk8s.Duration
+	PublishNotReadyAddresses *bool
+}
+
+func (options *ServiceOptions) defaultProps() {
+	if options.Type == "" {
+		options.Type = ServiceType_CLUSTER_IP
+	}
+}
+
+// NativeType is the cdk8s-plus type of the Service, a headless Service being
+// a ClusterIP one without an address.
+func (options *ServiceOptions) NativeType() cdk8splus28.ServiceType {
+
+	options.defaultProps()
+
+	if options.Type == ServiceType_NONE {
+		return cdk8splus28.ServiceType_CLUSTER_IP
+	}
+
+	return cdk8splus28.ServiceType(options.Type)
+}
+
+// Apply sets on the Service everything cdk8s-plus does not take from its
+// props. The Service must already have its ports.
+func (options *ServiceOptions) Apply(service cdk8splus28.Service) {
+
+	options.defaultProps()
+
+	// Патчи применяются к свойствам L1 объекта, поэтому ключи записаны как
+	// в ServiceSpec: clusterIp, clientIp
+	patch := func(path string, value interface{}) {
+		service.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String(path), value))
+	}
+
+	if options.Type == ServiceType_NONE {
+		patch("/spec/clusterIp", jsii.String("None"))
+	}
+	if options.NodePort != nil {
+		patch("/spec/ports/0/nodePort", options.NodePort)
+	}
+	if options.LoadBalancerSourceRanges != nil {
+		patch("/spec/loadBalancerSourceRanges", options.LoadBalancerSourceRanges)
+	}
+	if options.ExternalTrafficPolicy != nil {
+		patch("/spec/externalTrafficPolicy", options.ExternalTrafficPolicy)
+	}
+	if options.SessionAffinity != nil {
+		patch("/spec/sessionAffinity", options.SessionAffinity)
+	}
+	if options.SessionAffinityTimeout != nil {
+		patch("/spec/sessionAffinityConfig", map[string]interface{}{
+			"clientIp": map[string]interface{}{
+				"timeoutSeconds": options.SessionAffinityTimeout.ToSeconds(nil),
+			},
+		})
+	}
+	if options.PublishNotReadyAddresses != nil {
+		patch("/spec/publishNotReadyAddresses", options.PublishNotReadyAddresses)
+	}
+
+	if options.Annotations != nil {
+		for key, value := range *options.Annotations {
+			service.Metadata().AddAnnotation(jsii.String(key), value)
+		}
+	}
+}
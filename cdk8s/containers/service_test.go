@@ -0,0 +1,99 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+// renderService renders a Service with the options and returns its manifest.
+func renderService(t *testing.T, options *ServiceOptions) map[string]interface{} {
+
+	app, chart := synth.NewChart("")
+
+	service := cdk8splus28.NewService(chart, jsii.String("service"), &cdk8splus28.ServiceProps{
+		Type:  options.NativeType(),
+		Ports: ServicePorts([]*Port{{Port: jsii.Number(80), ContainerPort: jsii.Number(8080)}}),
+	})
+
+	options.Apply(service)
+
+	return synth.Find(t, synth.Manifests(app), "Service")
+}
+
+func TestServiceHeadless(t *testing.T) {
+
+	service := renderService(t, &ServiceOptions{Type: ServiceType_NONE})
+
+	if kind := synth.Field(service, "spec", "type"); kind != "ClusterIP" {
+		t.Errorf("expected a ClusterIP Service, got %v", kind)
+	}
+	if address := synth.Field(service, "spec", "clusterIP"); address != "None" {
+		t.Errorf("expected no cluster IP, got %v", address)
+	}
+}
+
+func TestServiceNodePort(t *testing.T) {
+
+	service := renderService(t, &ServiceOptions{
+		Type:                  ServiceType_NODE_PORT,
+		NodePort:              jsii.Number(30080),
+		ExternalTrafficPolicy: jsii.String("Local"),
+	})
+
+	if kind := synth.Field(service, "spec", "type"); kind != "NodePort" {
+		t.Errorf("expected a NodePort Service, got %v", kind)
+	}
+	if port := synth.Field(service, "spec", "ports", 0, "nodePort"); port != float64(30080) {
+		t.Errorf("expected node port 30080, got %v", port)
+	}
+	if policy := synth.Field(service, "spec", "externalTrafficPolicy"); policy != "Local" {
+		t.Errorf("expected the Local traffic policy, got %v", policy)
+	}
+}
+
+func TestServiceLoadBalancer(t *testing.T) {
+
+	service := renderService(t, &ServiceOptions{
+		Type:                     ServiceType_LOAD_BALANCER,
+		LoadBalancerSourceRanges: &[]*string{jsii.String("10.0.0.0/8")},
+		Annotations: &map[string]*string{
+			"service.beta.kubernetes.io/aws-load-balancer-internal": jsii.String("true"),
+		},
+	})
+
+	if kind := synth.Field(service, "spec", "type"); kind != "LoadBalancer" {
+		t.Errorf("expected a LoadBalancer Service, got %v", kind)
+	}
+	if source := synth.Field(service, "spec", "loadBalancerSourceRanges", 0); source != "10.0.0.0/8" {
+		t.Errorf("expected the source range, got %v", source)
+	}
+	if annotation := synth.Field(service, "metadata", "annotations", "service.beta.kubernetes.io/aws-load-balancer-internal"); annotation != "true" {
+		t.Errorf("expected the load balancer annotation, got %v", annotation)
+	}
+}
+
+func TestServiceSessionAffinity(t *testing.T) {
+
+	service := renderService(t, &ServiceOptions{
+		SessionAffinity:          jsii.String("ClientIP"),
+		SessionAffinityTimeout:   cdk8s.Duration_Minutes(jsii.Number(30)),
+		PublishNotReadyAddresses: jsii.Bool(true),
+	})
+
+	if kind := synth.Field(service, "spec", "type"); kind != "ClusterIP" {
+		t.Errorf("expected a ClusterIP Service by default, got %v", kind)
+	}
+	if affinity := synth.Field(service, "spec", "sessionAffinity"); affinity != "ClientIP" {
+		t.Errorf("expected the ClientIP affinity, got %v", affinity)
+	}
+	if timeout := synth.Field(service, "spec", "sessionAffinityConfig", "clientIP", "timeoutSeconds"); timeout != float64(1800) {
+		t.Errorf("expected a 1800 seconds timeout, got %v", timeout)
+	}
+	if publish := synth.Field(service, "spec", "publishNotReadyAddresses"); publish != true {
+		t.Errorf("expected not ready addresses to be published, got %v", publish)
+	}
+}
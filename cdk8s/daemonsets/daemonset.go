@@ -0,0 +1,303 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
+)
+
+type DaemonSetResource struct {
+	DaemonSet      cdk8splus28.DaemonSet
+	ServiceAccount pods.ServiceAccountResource
+}
+
+// HostPathMount mounts a directory or file of the node into the container.
+type HostPathMount struct {
+	Path     *string
+	Type     cdk8splus28.HostPathVolumeType
+	ReadOnly *bool
+}
+
+// DaemonSetUpdate is the rolling update of a DaemonSet, or OnDelete when set:
+// pods are then replaced only after being deleted by hand.
+type DaemonSetUpdate struct {
+	MaxUnavailable k8s.IntOrString
+	MaxSurge       k8s.IntOrString
+	OnDelete       *bool
+}
+
+func (update *DaemonSetUpdate) strategy() *k8s.DaemonSetUpdateStrategy {
+
+	if update.OnDelete != nil && *update.OnDelete {
+		return &k8s.DaemonSetUpdateStrategy{
+			Type: jsii.String("OnDelete"),
+		}
+	}
+
+	maxUnavailable := update.MaxUnavailable
+	if maxUnavailable == nil {
+		maxUnavailable = k8s.IntOrString_FromNumber(jsii.Number(1))
+	}
+
+	return &k8s.DaemonSetUpdateStrategy{
+		Type: jsii.String("RollingUpdate"),
+		RollingUpdate: &k8s.RollingUpdateDaemonSet{
+			MaxUnavailable: maxUnavailable,
+			MaxSurge:       update.MaxSurge,
+		},
+	}
+}
+
+type DaemonSetProps struct {
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Ports                    *[]*containers.Port
+	Network                  *string
+	Labels                   *recommended.Labels
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	HostPaths                *map[*string]*HostPathMount
+	HostNetwork              *bool
+	HostPid                  *bool
+	TolerateControlPlane     *bool
+	Update                   *DaemonSetUpdate
+	MinReady                 *float64
+	Resources                *cdk8splus28.ContainerResources
+	Liveness                 cdk8splus28.Probe
+	Readiness                cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+}
+
+func (props *DaemonSetProps) defaultProps() {
+	if props.Ports == nil {
+		props.Ports = &[]*containers.Port{}
+	}
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[*string]*cdk8splus28.Volume{}
+	}
+	if props.HostPaths == nil {
+		props.HostPaths = &map[*string]*HostPathMount{}
+	}
+	if props.HostNetwork == nil {
+		props.HostNetwork = jsii.Bool(false)
+	}
+	if props.HostPid == nil {
+		props.HostPid = jsii.Bool(false)
+	}
+	if props.TolerateControlPlane == nil {
+		props.TolerateControlPlane = jsii.Bool(false)
+	}
+	if props.Update == nil {
+		props.Update = &DaemonSetUpdate{}
+	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+}
+
+func hostPathVolumeName(path string) string {
+	return fmt.Sprintf("host-%s", strings.ReplaceAll(strings.Trim(path, "/"), "/", "-"))
+}
+
+func NewDaemonSet(
+	scope constructs.Construct,
+	id string,
+	image *string,
+	props *DaemonSetProps,
+) DaemonSetResource {
+
+	props.defaultProps()
+
+	tracker := recommended.Track(scope, id, image, props.Labels)
+
+	ports := *props.Ports
+
+	additionalPorts := containers.AdditionalContainerPorts(ports)
+
+	var portNumber *float64
+
+	if len(ports) > 0 {
+		portNumber = ports[0].ContainerPort
+	}
+
+	container := cdk8splus28.NewContainer(&cdk8splus28.ContainerProps{
+		Name:            jsii.String(id),
+		Image:           image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		WorkingDir:      props.WorkingDir,
+		PortNumber:      portNumber,
+		Ports:           additionalPorts,
+		Resources:       props.Resources,
+		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
+			ReadOnlyRootFilesystem: jsii.Bool(false),
+			EnsureNonRoot:          jsii.Bool(false),
+		},
+		Liveness:  containers.NativeProbe(props.Liveness),
+		Readiness: containers.NativeProbe(props.Readiness),
+		Lifecycle: containers.Lifecycle(props.PostStart, props.PreStop),
+	})
+
+	for _, k := range containers.SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
+	}
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
+		container.Mount(path, storage, nil)
+	}
+
+	containers.MountVolumes(container, props.Mounts)
+
+	labels := make(map[string]*string)
+
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	var account pods.ServiceAccountResource
+	var serviceAccount cdk8splus28.IServiceAccount
+	var automountToken *bool
+
+	if props.ServiceAccount != nil {
+		account = pods.NewServiceAccount(scope, "service-account", props.ServiceAccount)
+		serviceAccount = account.ServiceAccount
+		automountToken = props.ServiceAccount.AutomountToken
+	}
+
+	daemonset := cdk8splus28.NewDaemonSet(
+		scope,
+		jsii.String("daemonset"),
+		&cdk8splus28.DaemonSetProps{
+			MinReadySeconds:              props.MinReady,
+			HostNetwork:                  props.HostNetwork,
+			TerminationGracePeriod:       props.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
+			SecurityContext: &cdk8splus28.PodSecurityContextProps{
+				EnsureNonRoot: jsii.Bool(false),
+			},
+			PodMetadata: &cdk8s.ApiObjectMetadata{
+				Labels: &labels,
+			},
+		},
+	)
+
+	daemonset.AttachContainer(container)
+
+	if len(ports) > 0 {
+		containers.PatchPrimaryContainerPort(daemonset.ApiObject(), "/spec/template/spec/containers/0/ports/0", ports)
+	}
+
+	for _, path := range containers.SortedKeys(*props.HostPaths) {
+		hostPath := (*props.HostPaths)[path]
+		name := jsii.String(hostPathVolumeName(*hostPath.Path))
+		volume := cdk8splus28.Volume_FromHostPath(daemonset, name, name, &cdk8splus28.HostPathVolumeOptions{
+			Path: hostPath.Path,
+			Type: hostPath.Type,
+		})
+		container.Mount(path, volume, &cdk8splus28.MountOptions{
+			ReadOnly: hostPath.ReadOnly,
+		})
+	}
+
+	if *props.HostPid {
+		// Патч применяется к свойствам L1 объекта до их преобразования в манифест,
+		// поэтому ключ пишется как в KubeDaemonSetProps, а не как hostPID
+		daemonset.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String("/spec/template/spec/hostPid"),
+			props.HostPid,
+		))
+	}
+
+	daemonset.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String("/spec/updateStrategy"),
+		props.Update.strategy(),
+	))
+
+	containers.PatchTerminationMessagePolicy(daemonset.ApiObject(), "/spec/template/spec", props.TerminationMessagePolicy)
+
+	containers.ConfigureEnv(daemonset, container, props.Environment, props.EnvFrom)
+
+	containers.AttachContainers(daemonset, "/spec/template/spec", props.InitContainers, props.Sidecars)
+
+	containers.AttachPullSecrets(daemonset, "/spec/template/spec")
+
+	containers.AttachChecksum(daemonset, "/spec/template/spec")
+
+	scheduling := props.Scheduling
+
+	if *props.TolerateControlPlane {
+		merged := pods.SchedulingProps{}
+		if scheduling != nil {
+			merged = *scheduling
+		}
+		tolerations := []*k8s.Toleration{
+			{
+				Key:      jsii.String("node-role.kubernetes.io/control-plane"),
+				Operator: jsii.String("Exists"),
+				Effect:   jsii.String("NoSchedule"),
+			},
+		}
+		if merged.Tolerations != nil {
+			tolerations = append(tolerations, *merged.Tolerations...)
+		}
+		merged.Tolerations = &tolerations
+		scheduling = &merged
+	}
+
+	if scheduling != nil {
+		scheduling.Apply(daemonset.ApiObject(), "/spec/template/spec", &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(daemonset, "/spec/template/spec")
+	}
+
+	containers.PatchGrpcProbe(daemonset.ApiObject(), "/spec/template/spec/containers/0/livenessProbe", props.Liveness, portNumber)
+	containers.PatchGrpcProbe(daemonset.ApiObject(), "/spec/template/spec/containers/0/readinessProbe", props.Readiness, portNumber)
+
+	daemonset.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		daemonset.AddVolume(*(*props.Volumes)[path])
+	}
+
+	tracker.Apply()
+
+	return DaemonSetResource{
+		DaemonSet:      daemonset,
+		ServiceAccount: account,
+	}
+}
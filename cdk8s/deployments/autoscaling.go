@@ -0,0 +1,79 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// AutoscalingProps configures an autoscaling/v2 HorizontalPodAutoscaler.
+// CpuUtilization and MemoryUtilization are average utilization targets in percent,
+// Metrics takes any additional (pods, object or external) metrics.
+type AutoscalingProps struct {
+	MinReplicas       *float64
+	MaxReplicas       *float64
+	CpuUtilization    *float64
+	MemoryUtilization *float64
+	Metrics           *[]cdk8splus28.Metric
+	ScaleUp           *cdk8splus28.ScalingRules
+	ScaleDown         *cdk8splus28.ScalingRules
+}
+
+func (props *AutoscalingProps) defaultProps() {
+	if props.MinReplicas == nil {
+		props.MinReplicas = jsii.Number(1)
+	}
+	if props.MaxReplicas == nil {
+		props.MaxReplicas = jsii.Number(3)
+	}
+	if props.CpuUtilization == nil && props.MemoryUtilization == nil && props.Metrics == nil {
+		props.CpuUtilization = jsii.Number(80)
+	}
+}
+
+func newAutoscaler(
+	scope constructs.Construct,
+	id string,
+	deployment cdk8splus28.Deployment,
+	props *AutoscalingProps,
+) cdk8splus28.HorizontalPodAutoscaler {
+
+	props.defaultProps()
+
+	metrics := []cdk8splus28.Metric{}
+
+	if props.CpuUtilization != nil {
+		metrics = append(metrics, cdk8splus28.Metric_ResourceCpu(
+			cdk8splus28.MetricTarget_AverageUtilization(props.CpuUtilization),
+		))
+	}
+	if props.MemoryUtilization != nil {
+		metrics = append(metrics, cdk8splus28.Metric_ResourceMemory(
+			cdk8splus28.MetricTarget_AverageUtilization(props.MemoryUtilization),
+		))
+	}
+	if props.Metrics != nil {
+		metrics = append(metrics, *props.Metrics...)
+	}
+
+	autoscaler := cdk8splus28.NewHorizontalPodAutoscaler(
+		scope,
+		jsii.String("autoscaler"),
+		&cdk8splus28.HorizontalPodAutoscalerProps{
+			Metadata: &cdk8s.ApiObjectMetadata{
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+			Target:      deployment,
+			MinReplicas: props.MinReplicas,
+			MaxReplicas: props.MaxReplicas,
+			Metrics:     &metrics,
+			ScaleUp:     props.ScaleUp,
+			ScaleDown:   props.ScaleDown,
+		},
+	)
+
+	return autoscaler
+}
@@ -0,0 +1,98 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendAutoscaling(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		Autoscaling: &AutoscalingProps{
+			MinReplicas:       jsii.Number(2),
+			MaxReplicas:       jsii.Number(10),
+			MemoryUtilization: jsii.Number(70),
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	deployment := synth.Find(t, manifests, "Deployment")
+	autoscaler := synth.Find(t, manifests, "HorizontalPodAutoscaler")
+
+	if replicas := synth.Field(deployment, "spec", "replicas"); replicas != nil {
+		t.Errorf("expected the autoscaler to own the replicas, got %v", replicas)
+	}
+	if version := autoscaler["apiVersion"]; version != "autoscaling/v2" {
+		t.Errorf("expected autoscaling/v2, got %v", version)
+	}
+	if name := synth.Field(autoscaler, "spec", "scaleTargetRef", "name"); name != synth.Field(deployment, "metadata", "name") {
+		t.Errorf("expected the autoscaler to target the deployment, got %v", name)
+	}
+	if replicas := synth.Field(autoscaler, "spec", "minReplicas"); replicas != float64(2) {
+		t.Errorf("expected 2 min replicas, got %v", replicas)
+	}
+	if replicas := synth.Field(autoscaler, "spec", "maxReplicas"); replicas != float64(10) {
+		t.Errorf("expected 10 max replicas, got %v", replicas)
+	}
+
+	metrics := synth.Field(autoscaler, "spec", "metrics").([]interface{})
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected the memory metric alone, got %v", metrics)
+	}
+	if name := synth.Field(metrics, 0, "resource", "name"); name != "memory" {
+		t.Errorf("expected the memory metric, got %v", name)
+	}
+	if target := synth.Field(metrics, 0, "resource", "target", "averageUtilization"); target != float64(70) {
+		t.Errorf("expected a 70%% target, got %v", target)
+	}
+}
+
+func TestFrontendAutoscalingDefaults(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	resource := newTestFrontend(chart, &FrontendProps{
+		Autoscaling: &AutoscalingProps{},
+	})
+
+	if resource.Autoscaler == nil {
+		t.Fatal("expected an autoscaler")
+	}
+
+	autoscaler := synth.Find(t, synth.Manifests(app), "HorizontalPodAutoscaler")
+
+	if replicas := synth.Field(autoscaler, "spec", "minReplicas"); replicas != float64(1) {
+		t.Errorf("expected 1 min replica, got %v", replicas)
+	}
+	if replicas := synth.Field(autoscaler, "spec", "maxReplicas"); replicas != float64(3) {
+		t.Errorf("expected 3 max replicas, got %v", replicas)
+	}
+	if name := synth.Field(autoscaler, "spec", "metrics", 0, "resource", "name"); name != "cpu" {
+		t.Errorf("expected the default cpu metric, got %v", name)
+	}
+	if target := synth.Field(autoscaler, "spec", "metrics", 0, "resource", "target", "averageUtilization"); target != float64(80) {
+		t.Errorf("expected an 80%% target, got %v", target)
+	}
+}
+
+func TestBackendWithoutAutoscaling(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{})
+
+	manifests := synth.Manifests(app)
+
+	if autoscalers := synth.All(manifests, "HorizontalPodAutoscaler"); len(autoscalers) != 0 {
+		t.Errorf("expected no autoscaler, got %d", len(autoscalers))
+	}
+	if replicas := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "replicas"); replicas != float64(1) {
+		t.Errorf("expected one replica, got %v", replicas)
+	}
+}
@@ -7,23 +7,65 @@ import (
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/cdk8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
 )
 
 type BackendResource struct {
-	Deployment cdk8splus28.Deployment
-	Service    cdk8splus28.Service
+	Deployment       cdk8splus28.Deployment
+	Service          cdk8splus28.Service
+	Autoscaler       cdk8splus28.HorizontalPodAutoscaler
+	DisruptionBudget k8s.KubePodDisruptionBudget
+	ServiceAccount   pods.ServiceAccountResource
+	Monitor          cdk8s.ApiObject
 }
 
 type BackendPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      cdk8splus28.Protocol
+	AppProtocol   *string
 }
 
 type BackendProps struct {
-	Ports     *BackendPort
-	Network   *string
-	Variables *map[*string]*string
-	Volumes   *map[*string]*cdk8splus28.Volume
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Ports                    *BackendPort
+	AdditionalPorts          *[]*BackendPort
+	Network                  *string
+	Labels                   *recommended.Labels
+	Service                  *containers.ServiceOptions
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	Resources                *cdk8splus28.ContainerResources
+	Autoscaling              *AutoscalingProps
+	Strategy                 cdk8splus28.DeploymentStrategy
+	MinReady                 cdk8s.Duration
+	ProgressDeadline         cdk8s.Duration
+	RevisionHistoryLimit     *float64
+	DisruptionBudget         *DisruptionBudgetProps
+	Liveness                 cdk8splus28.Probe
+	Readiness                cdk8splus28.Probe
+	Startup                  cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+	Metrics                  *monitoring.MetricsProps
 }
 
 func (props *BackendProps) defaultProps() {
@@ -37,9 +79,42 @@ func (props *BackendProps) defaultProps() {
 	if props.Ports.ContainerPort == nil {
 		props.Ports.ContainerPort = jsii.Number(8080)
 	}
+	if props.Service == nil {
+		props.Service = &containers.ServiceOptions{}
+	}
 	if props.Volumes == nil {
 		props.Volumes = &map[*string]*cdk8splus28.Volume{}
 	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+}
+
+func (props *BackendProps) ports() []*containers.Port {
+
+	ports := []*containers.Port{
+		{
+			Name:          props.Ports.Name,
+			Port:          props.Ports.Port,
+			ContainerPort: props.Ports.ContainerPort,
+			Protocol:      props.Ports.Protocol,
+			AppProtocol:   props.Ports.AppProtocol,
+		},
+	}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			ports = append(ports, &containers.Port{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
+
+	return ports
 }
 
 func NewBackend(
@@ -51,41 +126,78 @@ func NewBackend(
 
 	props.defaultProps()
 
+	tracker := recommended.Track(scope, id, image, props.Labels)
+
+	ports := props.ports()
+
 	container := cdk8splus28.NewContainer(&cdk8splus28.ContainerProps{
-		Name:       jsii.String(id),
-		Image:      image,
-		PortNumber: props.Ports.ContainerPort,
-		Resources: &cdk8splus28.ContainerResources{
-			Cpu:              nil,
-			EphemeralStorage: nil,
-			Memory:           nil,
-		},
+		Name:            jsii.String(id),
+		Image:           image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		WorkingDir:      props.WorkingDir,
+		PortNumber:      props.Ports.ContainerPort,
+		Ports:           containers.AdditionalContainerPorts(ports),
+		Resources:       props.Resources,
 		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
 			ReadOnlyRootFilesystem: jsii.Bool(false),
 			EnsureNonRoot:          jsii.Bool(false),
 		},
+		Liveness:  containers.NativeProbe(props.Liveness),
+		Readiness: containers.NativeProbe(props.Readiness),
+		Startup:   containers.NativeProbe(props.Startup),
+		Lifecycle: containers.Lifecycle(props.PostStart, props.PreStop),
 	})
 
-	for k, v := range *props.Variables {
-		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue(v))
+	for _, k := range containers.SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
 	}
 
-	for path, volume := range *props.Volumes {
-		var storage cdk8splus28.IStorage = *volume
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
 		container.Mount(path, storage, nil)
 	}
 
+	containers.MountVolumes(container, props.Mounts)
+
 	labels := make(map[string]*string)
 
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
 	if props.Network != nil {
 		labels[*props.Network] = jsii.String("true")
 	}
 
+	var account pods.ServiceAccountResource
+	var serviceAccount cdk8splus28.IServiceAccount
+	var automountToken *bool
+
+	if props.ServiceAccount != nil {
+		account = pods.NewServiceAccount(scope, "service-account", props.ServiceAccount)
+		serviceAccount = account.ServiceAccount
+		automountToken = props.ServiceAccount.AutomountToken
+	}
+
+	var replicas *float64
+
+	if props.Autoscaling == nil {
+		replicas = jsii.Number(1)
+	}
+
 	deployment := cdk8splus28.NewDeployment(
 		scope,
 		jsii.String("deployment"),
 		&cdk8splus28.DeploymentProps{
-			Replicas: jsii.Number(1),
+			Replicas:                     replicas,
+			Strategy:                     props.Strategy,
+			MinReady:                     props.MinReady,
+			ProgressDeadline:             props.ProgressDeadline,
+			TerminationGracePeriod:       props.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
 			SecurityContext: &cdk8splus28.PodSecurityContextProps{
 				EnsureNonRoot: jsii.Bool(false),
 			},
@@ -97,26 +209,85 @@ func NewBackend(
 
 	deployment.AttachContainer(container)
 
+	if props.RevisionHistoryLimit != nil {
+		deployment.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String("/spec/revisionHistoryLimit"),
+			props.RevisionHistoryLimit,
+		))
+	}
+
+	containers.PatchPrimaryContainerPort(deployment.ApiObject(), "/spec/template/spec/containers/0/ports/0", ports)
+
+	containers.PatchTerminationMessagePolicy(deployment.ApiObject(), "/spec/template/spec", props.TerminationMessagePolicy)
+
+	containers.ConfigureEnv(deployment, container, props.Environment, props.EnvFrom)
+
+	containers.AttachContainers(deployment, "/spec/template/spec", props.InitContainers, props.Sidecars)
+
+	containers.AttachPullSecrets(deployment, "/spec/template/spec")
+
+	containers.AttachChecksum(deployment, "/spec/template/spec")
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(deployment.ApiObject(), "/spec/template/spec", &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(deployment, "/spec/template/spec")
+	}
+
+	containers.PatchGrpcProbe(deployment.ApiObject(), "/spec/template/spec/containers/0/livenessProbe", props.Liveness, props.Ports.ContainerPort)
+	containers.PatchGrpcProbe(deployment.ApiObject(), "/spec/template/spec/containers/0/readinessProbe", props.Readiness, props.Ports.ContainerPort)
+	containers.PatchGrpcProbe(deployment.ApiObject(), "/spec/template/spec/containers/0/startupProbe", props.Startup, props.Ports.ContainerPort)
+
 	deployment.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
 
 	service := deployment.ExposeViaService(&cdk8splus28.DeploymentExposeViaServiceOptions{
 		Name:        jsii.String(fmt.Sprintf("%s-service", id)),
-		ServiceType: cdk8splus28.ServiceType_CLUSTER_IP,
-		Ports: &[]*cdk8splus28.ServicePort{
-			{
-				Name:       jsii.String(fmt.Sprintf("%d", int(*props.Ports.Port))),
-				Port:       props.Ports.Port,
-				TargetPort: props.Ports.ContainerPort,
-			},
-		},
+		ServiceType: props.Service.NativeType(),
+		Ports:       containers.ServicePorts(ports),
 	})
 
-	for _, volume := range *props.Volumes {
-		deployment.AddVolume(*volume)
+	containers.PatchAppProtocols(service, ports)
+
+	props.Service.Apply(service)
+
+	service.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
+
+	var monitor cdk8s.ApiObject
+
+	if props.Metrics != nil {
+		monitor = monitoring.NewMonitor(scope, &map[string]*string{
+			"io.service": labels["io.service"],
+		}, ports[0], props.Metrics)
+	}
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		deployment.AddVolume(*(*props.Volumes)[path])
+	}
+
+	var autoscaler cdk8splus28.HorizontalPodAutoscaler
+
+	if props.Autoscaling != nil {
+		autoscaler = newAutoscaler(scope, id, deployment, props.Autoscaling)
+	}
+
+	var disruptionBudget k8s.KubePodDisruptionBudget
+
+	if props.DisruptionBudget != nil {
+		disruptionBudget = newDisruptionBudget(scope, id, deployment, props.DisruptionBudget)
 	}
 
+	tracker.Apply()
+
 	return BackendResource{
-		Deployment: deployment,
-		Service:    service,
+		Deployment:       deployment,
+		Service:          service,
+		Autoscaler:       autoscaler,
+		DisruptionBudget: disruptionBudget,
+		ServiceAccount:   account,
+		Monitor:          monitor,
 	}
 }
@@ -0,0 +1,92 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func newTestBackend(scope constructs.Construct, props *BackendProps) BackendResource {
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	return NewBackend(scope, "api", jsii.String("api:1.0"), props)
+}
+
+func TestBackendPorts(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		Ports: &BackendPort{
+			Name:          jsii.String("http"),
+			Port:          jsii.Number(80),
+			ContainerPort: jsii.Number(8080),
+		},
+		AdditionalPorts: &[]*BackendPort{
+			{
+				Name:          jsii.String("grpc"),
+				Port:          jsii.Number(9090),
+				ContainerPort: jsii.Number(9000),
+				AppProtocol:   jsii.String("kubernetes.io/h2c"),
+			},
+			{
+				ContainerPort: jsii.Number(5353),
+				Protocol:      cdk8splus28.Protocol_UDP,
+			},
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	service := synth.Find(t, manifests, "Service")
+
+	expected := []map[string]interface{}{
+		{"name": "http", "port": float64(80), "targetPort": float64(8080)},
+		{"name": "grpc", "port": float64(9090), "targetPort": float64(9000), "appProtocol": "kubernetes.io/h2c"},
+		{"name": "5353", "port": float64(5353), "targetPort": float64(5353), "protocol": "UDP"},
+	}
+
+	for i, fields := range expected {
+		for key, value := range fields {
+			if actual := synth.Field(service, "spec", "ports", i, key); actual != value {
+				t.Errorf("service port %d %s: expected %v, got %v", i, key, value, actual)
+			}
+		}
+	}
+
+	container := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	containerPorts := map[float64]map[string]interface{}{}
+	for _, port := range synth.Field(container, "ports").([]interface{}) {
+		containerPorts[synth.Field(port, "containerPort").(float64)] = port.(map[string]interface{})
+	}
+
+	if name := synth.Field(containerPorts[8080], "name"); name != "http" {
+		t.Errorf("expected the primary container port named http, got %v", name)
+	}
+	if name := synth.Field(containerPorts[9000], "name"); name != "grpc" {
+		t.Errorf("expected the grpc container port, got %v", name)
+	}
+	if protocol := synth.Field(containerPorts[5353], "protocol"); protocol != "UDP" {
+		t.Errorf("expected the UDP container port, got %v", protocol)
+	}
+}
+
+func TestBackendPortRequiresNumber(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	if synth.Panics(func() {
+		newTestBackend(chart, &BackendProps{
+			AdditionalPorts: &[]*BackendPort{
+				{Name: jsii.String("metrics")},
+			},
+		})
+	}) == nil {
+		t.Error("expected a panic for a port without a number")
+	}
+}
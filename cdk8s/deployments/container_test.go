@@ -0,0 +1,62 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendSidecars(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		InitContainers: &[]*containers.ContainerProps{
+			{Name: jsii.String("migrate"), Image: jsii.String("api:1.0"), Args: &[]*string{jsii.String("migrate")}},
+		},
+		Sidecars: &[]*containers.ContainerProps{
+			{
+				Name:      jsii.String("proxy"),
+				Image:     jsii.String("envoy:1.30"),
+				Native:    jsii.Bool(true),
+				Ports:     &[]*containers.Port{{Name: jsii.String("admin"), Port: jsii.Number(9901)}},
+				Variables: &map[*string]*string{jsii.String("LOG_LEVEL"): jsii.String("info")},
+			},
+			{Name: jsii.String("logs"), Image: jsii.String("fluent-bit:3.0")},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+
+	names := func(key string) []interface{} {
+		result := []interface{}{}
+		for _, container := range synth.Field(spec, key).([]interface{}) {
+			result = append(result, synth.Field(container, "name"))
+		}
+		return result
+	}
+
+	if regular := names("containers"); len(regular) != 2 || regular[1] != "logs" {
+		t.Errorf("expected the logs sidecar next to the main container, got %v", regular)
+	}
+	if inits := names("initContainers"); len(inits) != 2 || inits[0] != "proxy" || inits[1] != "migrate" {
+		t.Errorf("expected the native proxy ahead of the migrate init container, got %v", inits)
+	}
+
+	proxy := synth.Field(spec, "initContainers", 0)
+
+	if policy := synth.Field(proxy, "restartPolicy"); policy != "Always" {
+		t.Errorf("expected a native sidecar, got restart policy %v", policy)
+	}
+	if port := synth.Field(proxy, "ports", 0, "containerPort"); port != float64(9901) {
+		t.Errorf("expected the admin port, got %v", port)
+	}
+	if variable := synth.Field(proxy, "env", 0, "name"); variable != "LOG_LEVEL" {
+		t.Errorf("expected the sidecar variable, got %v", variable)
+	}
+	if policy := synth.Field(spec, "initContainers", 1, "restartPolicy"); policy != nil {
+		t.Errorf("expected a regular init container, got restart policy %v", policy)
+	}
+}
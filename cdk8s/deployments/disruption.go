@@ -0,0 +1,52 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// DisruptionBudgetProps takes either MinAvailable or MaxUnavailable, as a
+// number of pods or a percentage. Without both at most one pod is evicted at a time.
+type DisruptionBudgetProps struct {
+	MinAvailable   k8s.IntOrString
+	MaxUnavailable k8s.IntOrString
+}
+
+func (props *DisruptionBudgetProps) defaultProps() {
+	if props.MinAvailable == nil && props.MaxUnavailable == nil {
+		props.MaxUnavailable = k8s.IntOrString_FromNumber(jsii.Number(1))
+	}
+}
+
+func newDisruptionBudget(
+	scope constructs.Construct,
+	id string,
+	deployment cdk8splus28.Deployment,
+	props *DisruptionBudgetProps,
+) k8s.KubePodDisruptionBudget {
+
+	props.defaultProps()
+
+	budget := k8s.NewKubePodDisruptionBudget(
+		scope,
+		jsii.String("disruption-budget"),
+		&k8s.KubePodDisruptionBudgetProps{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+			Spec: &k8s.PodDisruptionBudgetSpec{
+				MinAvailable:   props.MinAvailable,
+				MaxUnavailable: props.MaxUnavailable,
+				Selector: &k8s.LabelSelector{
+					MatchLabels: deployment.MatchLabels(),
+				},
+			},
+		},
+	)
+
+	return budget
+}
@@ -0,0 +1,95 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendUpdateStrategy(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		Strategy: cdk8splus28.DeploymentStrategy_RollingUpdate(&cdk8splus28.DeploymentStrategyRollingUpdateOptions{
+			MaxSurge:       cdk8splus28.PercentOrAbsolute_Absolute(jsii.Number(2)),
+			MaxUnavailable: cdk8splus28.PercentOrAbsolute_Absolute(jsii.Number(0)),
+		}),
+		MinReady:             cdk8s.Duration_Seconds(jsii.Number(10)),
+		ProgressDeadline:     cdk8s.Duration_Minutes(jsii.Number(5)),
+		RevisionHistoryLimit: jsii.Number(3),
+	})
+
+	deployment := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	expected := map[string]interface{}{
+		"minReadySeconds":         float64(10),
+		"progressDeadlineSeconds": float64(300),
+		"revisionHistoryLimit":    float64(3),
+	}
+
+	for key, value := range expected {
+		if actual := synth.Field(deployment, "spec", key); actual != value {
+			t.Errorf("%s: expected %v, got %v", key, value, actual)
+		}
+	}
+
+	if kind := synth.Field(deployment, "spec", "strategy", "type"); kind != "RollingUpdate" {
+		t.Errorf("expected a rolling update, got %v", kind)
+	}
+	if surge := synth.Field(deployment, "spec", "strategy", "rollingUpdate", "maxSurge"); surge != float64(2) {
+		t.Errorf("expected a surge of 2, got %v", surge)
+	}
+	if unavailable := synth.Field(deployment, "spec", "strategy", "rollingUpdate", "maxUnavailable"); unavailable != float64(0) {
+		t.Errorf("expected no unavailable pods, got %v", unavailable)
+	}
+}
+
+func TestBackendDisruptionBudget(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		DisruptionBudget: &DisruptionBudgetProps{
+			MinAvailable: k8s.IntOrString_FromString(jsii.String("50%")),
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	budget := synth.Find(t, manifests, "PodDisruptionBudget")
+
+	if available := synth.Field(budget, "spec", "minAvailable"); available != "50%" {
+		t.Errorf("expected half the pods available, got %v", available)
+	}
+	if unavailable := synth.Field(budget, "spec", "maxUnavailable"); unavailable != nil {
+		t.Errorf("expected no maxUnavailable next to minAvailable, got %v", unavailable)
+	}
+
+	labels := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "selector", "matchLabels").(map[string]interface{})
+
+	for key, value := range labels {
+		if selected := synth.Field(budget, "spec", "selector", "matchLabels", key); selected != value {
+			t.Errorf("expected the budget to select %s=%v, got %v", key, value, selected)
+		}
+	}
+}
+
+func TestBackendDisruptionBudgetDefault(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		DisruptionBudget: &DisruptionBudgetProps{},
+	})
+
+	budget := synth.Find(t, synth.Manifests(app), "PodDisruptionBudget")
+
+	if unavailable := synth.Field(budget, "spec", "maxUnavailable"); unavailable != float64(1) {
+		t.Errorf("expected one pod evicted at a time, got %v", unavailable)
+	}
+}
@@ -1,38 +1,98 @@
 package cdk8skit
 
 import (
-	"fmt"
-
 	"github.com/aws/constructs-go/constructs/v10"
-	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/cdk8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
 )
 
 type FrontendResource struct {
-	Deployment cdk8splus28.Deployment
-	Service    cdk8splus28.Service
-	Ingress    cdk8splus28.Ingress
+	Deployment       cdk8splus28.Deployment
+	Service          cdk8splus28.Service
+	Ingress          cdk8splus28.Ingress
+	HttpRoutes       []cdk8s.ApiObject
+	Autoscaler       cdk8splus28.HorizontalPodAutoscaler
+	DisruptionBudget k8s.KubePodDisruptionBudget
+	ServiceAccount   pods.ServiceAccountResource
+	Monitor          cdk8s.ApiObject
 }
 
 type FrontendPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      cdk8splus28.Protocol
+	AppProtocol   *string
 }
 
+// FrontendProps takes either Nginx or Traefik options, the controller serving
+// the Ingress.
 type FrontendProps struct {
-	Ports         *FrontendPort
-	Network       *string
-	Variables     *map[*string]*string
-	Volumes       *map[*string]*cdk8splus28.Volume
-	ClusterIssuer *string
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Ports                    *FrontendPort
+	AdditionalPorts          *[]*FrontendPort
+	IngressPort              *string
+	Network                  *string
+	Labels                   *recommended.Labels
+	Service                  *containers.ServiceOptions
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	Aliases                  *[]*string
+	Routes                   *[]*FrontendRoute
+	ClusterIssuer            *string
+	Tls                      *FrontendTls
+	Gateway                  *FrontendGateway
+	IngressClassName         *string
+	Nginx                    *NginxIngressOptions
+	Traefik                  *TraefikIngressOptions
+	Annotations              *map[string]*string
+	Resources                *cdk8splus28.ContainerResources
+	Autoscaling              *AutoscalingProps
+	Strategy                 cdk8splus28.DeploymentStrategy
+	MinReady                 cdk8s.Duration
+	ProgressDeadline         cdk8s.Duration
+	RevisionHistoryLimit     *float64
+	DisruptionBudget         *DisruptionBudgetProps
+	Liveness                 cdk8splus28.Probe
+	Readiness                cdk8splus28.Probe
+	Startup                  cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+	Metrics                  *monitoring.MetricsProps
 }
 
-func (props *FrontendProps) defaultProps() {
+func (props *FrontendProps) defaultProps(id string) {
 
+	if props.Nginx != nil && props.Traefik != nil {
+		// Ingress обслуживает один контроллер, аннотации другого он не читает
+		panic("Заданы одновременно параметры Nginx и Traefik")
+	}
 	if props.Ports == nil {
 		props.Ports = &FrontendPort{}
 	}
+	if props.Tls == nil {
+		props.Tls = &FrontendTls{}
+	}
+
+	props.Tls.defaultProps(id, props.ClusterIssuer)
 }
 
 func NewFrontend(
@@ -43,52 +103,94 @@ func NewFrontend(
 	props *FrontendProps,
 ) FrontendResource {
 
-	props.defaultProps()
+	props.defaultProps(id)
+
+	tracker := recommended.Track(scope, id, image, props.Labels)
+
+	additionalPorts := []*BackendPort{}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			additionalPorts = append(additionalPorts, &BackendPort{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
 
-	backend := NewBackend(scope, id, image, &BackendProps{
+	backendProps := &BackendProps{
 		Ports: &BackendPort{
+			Name:          props.Ports.Name,
 			Port:          props.Ports.Port,
 			ContainerPort: props.Ports.ContainerPort,
+			Protocol:      props.Ports.Protocol,
+			AppProtocol:   props.Ports.AppProtocol,
 		},
-		Network:   props.Network,
-		Variables: props.Variables,
-		Volumes:   props.Volumes,
-	})
+		AdditionalPorts:          &additionalPorts,
+		ImagePullPolicy:          props.ImagePullPolicy,
+		Command:                  props.Command,
+		Args:                     props.Args,
+		WorkingDir:               props.WorkingDir,
+		Network:                  props.Network,
+		Labels:                   props.Labels,
+		Service:                  props.Service,
+		Variables:                props.Variables,
+		Environment:              props.Environment,
+		EnvFrom:                  props.EnvFrom,
+		Volumes:                  props.Volumes,
+		Mounts:                   props.Mounts,
+		Resources:                props.Resources,
+		Autoscaling:              props.Autoscaling,
+		Strategy:                 props.Strategy,
+		MinReady:                 props.MinReady,
+		ProgressDeadline:         props.ProgressDeadline,
+		RevisionHistoryLimit:     props.RevisionHistoryLimit,
+		DisruptionBudget:         props.DisruptionBudget,
+		Liveness:                 props.Liveness,
+		Readiness:                props.Readiness,
+		Startup:                  props.Startup,
+		PostStart:                props.PostStart,
+		PreStop:                  props.PreStop,
+		TerminationGracePeriod:   props.TerminationGracePeriod,
+		TerminationMessagePolicy: props.TerminationMessagePolicy,
+		InitContainers:           props.InitContainers,
+		Sidecars:                 props.Sidecars,
+		Scheduling:               props.Scheduling,
+		SecurityProfile:          props.SecurityProfile,
+		ServiceAccount:           props.ServiceAccount,
+		Metrics:                  props.Metrics,
+	}
+
+	backend := NewBackend(scope, id, image, backendProps)
+
+	ingressPort := containers.FindPort(backendProps.ports(), props.IngressPort)
+
+	routes := props.routes(host, backend.Service, ingressPort.Port)
 
-	annotations := make(map[string]*string)
+	var ingress cdk8splus28.Ingress
 
-	if props.ClusterIssuer != nil {
-		annotations["cert-manager.io/cluster-issuer"] = props.ClusterIssuer
+	var httpRoutes []cdk8s.ApiObject
+
+	if props.Gateway != nil {
+		props.Gateway.defaultProps(props.Tls)
+		httpRoutes = newHttpRoutes(scope, id, props.Gateway, routes)
+	} else {
+		ingress = newIngress(scope, id, props, backend.Service, routes)
 	}
 
-	ingress := cdk8splus28.NewIngress(scope, jsii.String("ingress"), &cdk8splus28.IngressProps{
-		Metadata: &cdk8s.ApiObjectMetadata{
-			Labels: &map[string]*string{
-				"io.service": jsii.String(id),
-			},
-			Annotations: &annotations,
-		},
-		Rules: &[]*cdk8splus28.IngressRule{
-			{
-				Host:     host,
-				Path:     jsii.String("/"),
-				Backend:  cdk8splus28.IngressBackend_FromService(backend.Service, nil),
-				PathType: cdk8splus28.HttpIngressPathType_PREFIX,
-			},
-		},
-		Tls: &[]*cdk8splus28.IngressTls{
-			{
-				Hosts: &[]*string{
-					host,
-				},
-				Secret: cdk8splus28.Secret_FromSecretName(scope, &id, jsii.String(fmt.Sprintf("%s-tls", id))),
-			},
-		},
-	})
+	tracker.Apply()
 
 	return FrontendResource{
-		Deployment: backend.Deployment,
-		Service:    backend.Service,
-		Ingress:    ingress,
+		Deployment:       backend.Deployment,
+		Service:          backend.Service,
+		Ingress:          ingress,
+		HttpRoutes:       httpRoutes,
+		Autoscaler:       backend.Autoscaler,
+		DisruptionBudget: backend.DisruptionBudget,
+		ServiceAccount:   backend.ServiceAccount,
+		Monitor:          backend.Monitor,
 	}
 }
@@ -0,0 +1,147 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// FrontendGateway binds the Frontend to a Gateway API listener instead of an
+// Ingress. SectionName defaults to the "https" listener, or "http" when TLS is off.
+type FrontendGateway struct {
+	Name        *string
+	Namespace   *string
+	SectionName *string
+}
+
+func (gateway *FrontendGateway) defaultProps(tls *FrontendTls) {
+	if gateway.SectionName == nil {
+		if tls.Mode == TlsMode_NONE {
+			gateway.SectionName = jsii.String("http")
+		} else {
+			gateway.SectionName = jsii.String("https")
+		}
+	}
+}
+
+// gatewayPathTypes maps Ingress path types to Gateway API ones. Gateway API
+// has no implementation specific type, so such routes are rejected rather
+// than reinterpreted as prefixes or regular expressions.
+var gatewayPathTypes = map[cdk8splus28.HttpIngressPathType]string{
+	cdk8splus28.HttpIngressPathType_PREFIX: "PathPrefix",
+	cdk8splus28.HttpIngressPathType_EXACT:  "Exact",
+}
+
+func (route *route) gatewayPathType() string {
+	pathType, ok := gatewayPathTypes[route.pathType]
+	if !ok {
+		panic(fmt.Sprintf("Тип пути %s не поддерживается Gateway API: %s", route.pathType, *route.path))
+	}
+	return pathType
+}
+
+// backendPort is the explicit route port or the first port of the service.
+func (route *route) backendPort() *float64 {
+	if route.port != nil {
+		return route.port
+	}
+	return (*route.service.Ports())[0].Port
+}
+
+func (route *route) rule() map[string]interface{} {
+	return map[string]interface{}{
+		"matches": &[]interface{}{
+			&map[string]interface{}{
+				"path": &map[string]interface{}{
+					"type":  jsii.String(route.gatewayPathType()),
+					"value": route.path,
+				},
+			},
+		},
+		"backendRefs": &[]interface{}{
+			&map[string]interface{}{
+				"name": route.service.Name(),
+				"port": route.backendPort(),
+			},
+		},
+	}
+}
+
+// newHttpRoutes renders the routing table as gateway.networking.k8s.io/v1
+// HTTPRoutes. Hostnames apply to a whole HTTPRoute, so hosts sharing the same
+// rules are grouped into one route.
+func newHttpRoutes(
+	scope constructs.Construct,
+	id string,
+	gateway *FrontendGateway,
+	routes []*route,
+) []cdk8s.ApiObject {
+
+	keys := []string{}
+	hostsByKey := make(map[string][]*string)
+	rulesByKey := make(map[string][]interface{})
+
+	for _, host := range routedHosts(routes) {
+		rules := []interface{}{}
+		signature := []string{}
+		for _, route := range routes {
+			if *route.host == *host {
+				rules = append(rules, route.rule())
+				signature = append(signature, fmt.Sprintf("%s|%s|%s|%g", *route.path, route.pathType, *route.service.Name(), *route.backendPort()))
+			}
+		}
+		key := strings.Join(signature, ";")
+		if _, ok := hostsByKey[key]; !ok {
+			keys = append(keys, key)
+			rulesByKey[key] = rules
+		}
+		hostsByKey[key] = append(hostsByKey[key], host)
+	}
+
+	parentRef := map[string]interface{}{
+		"name":        gateway.Name,
+		"sectionName": gateway.SectionName,
+	}
+	if gateway.Namespace != nil {
+		parentRef["namespace"] = gateway.Namespace
+	}
+
+	httpRoutes := []cdk8s.ApiObject{}
+
+	for i, key := range keys {
+		routeId := "httproute"
+		if i > 0 {
+			routeId = fmt.Sprintf("httproute-%d", i)
+		}
+
+		hosts := hostsByKey[key]
+		rules := rulesByKey[key]
+
+		httpRoute := cdk8s.NewApiObject(scope, jsii.String(routeId), &cdk8s.ApiObjectProps{
+			ApiVersion: jsii.String("gateway.networking.k8s.io/v1"),
+			Kind:       jsii.String("HTTPRoute"),
+			Metadata: &cdk8s.ApiObjectMetadata{
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+		})
+
+		httpRoute.AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String("/spec"),
+			&map[string]interface{}{
+				"parentRefs": &[]interface{}{&parentRef},
+				"hostnames":  &hosts,
+				"rules":      &rules,
+			},
+		))
+
+		httpRoutes = append(httpRoutes, httpRoute)
+	}
+
+	return httpRoutes
+}
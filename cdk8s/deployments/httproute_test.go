@@ -0,0 +1,93 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestHttpRoutes(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestFrontend(chart, &FrontendProps{
+		Aliases: &[]*string{jsii.String("www.example.com")},
+		Routes: &[]*FrontendRoute{
+			{},
+			{
+				Hosts:    &[]*string{jsii.String("api.example.com")},
+				Path:     jsii.String("/v1"),
+				PathType: cdk8splus28.HttpIngressPathType_EXACT,
+			},
+		},
+		Gateway: &FrontendGateway{
+			Name:      jsii.String("public"),
+			Namespace: jsii.String("gateways"),
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	if ingresses := synth.All(manifests, "Ingress"); len(ingresses) != 0 {
+		t.Errorf("expected no Ingress next to the routes, got %d", len(ingresses))
+	}
+
+	routes := synth.All(manifests, "HTTPRoute")
+	if len(routes) != 2 {
+		t.Fatalf("expected two HTTPRoutes, got %d", len(routes))
+	}
+
+	// Хосты с одинаковыми правилами попадают в один маршрут
+	shared := routes[0]
+
+	hostnames := synth.Field(shared, "spec", "hostnames").([]interface{})
+	if len(hostnames) != 2 || hostnames[0] != "example.com" || hostnames[1] != "www.example.com" {
+		t.Errorf("expected the host and its alias, got %v", hostnames)
+	}
+
+	parent := synth.Field(shared, "spec", "parentRefs", 0)
+	if synth.Field(parent, "name") != "public" || synth.Field(parent, "namespace") != "gateways" || synth.Field(parent, "sectionName") != "http" {
+		t.Errorf("expected the http listener of gateways/public, got %v", parent)
+	}
+
+	rule := synth.Field(shared, "spec", "rules", 0)
+	if pathType := synth.Field(rule, "matches", 0, "path", "type"); pathType != "PathPrefix" {
+		t.Errorf("expected a PathPrefix match, got %v", pathType)
+	}
+	if port := synth.Field(rule, "backendRefs", 0, "port"); port != float64(80) {
+		t.Errorf("expected the service port 80, got %v", port)
+	}
+
+	api := routes[1]
+
+	if hostname := synth.Field(api, "spec", "hostnames", 0); hostname != "api.example.com" {
+		t.Errorf("expected the api host, got %v", hostname)
+	}
+	path := synth.Field(api, "spec", "rules", 0, "matches", 0, "path")
+	if synth.Field(path, "type") != "Exact" || synth.Field(path, "value") != "/v1" {
+		t.Errorf("expected an exact /v1 match, got %v", path)
+	}
+}
+
+func TestHttpRoutesRejectImplementationSpecificPaths(t *testing.T) {
+
+	_, chart := synth.NewChart("apps")
+
+	if synth.Panics(func() {
+		newTestFrontend(chart, &FrontendProps{
+			Routes: &[]*FrontendRoute{
+				{
+					Path:     jsii.String("/static/.*"),
+					PathType: cdk8splus28.HttpIngressPathType_IMPLEMENTATION_SPECIFIC,
+				},
+			},
+			Gateway: &FrontendGateway{
+				Name: jsii.String("public"),
+			},
+		})
+	}) == nil {
+		t.Error("expected a panic for an implementation specific path")
+	}
+}
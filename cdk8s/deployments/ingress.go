@@ -0,0 +1,391 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+type IngressCors struct {
+	AllowOrigins     *[]*string
+	AllowMethods     *[]*string
+	AllowHeaders     *[]*string
+	AllowCredentials *bool
+	MaxAge           *float64
+}
+
+// IngressRateLimit limits requests per second per client. Connections caps
+// concurrent connections per client (nginx) or in-flight requests (Traefik).
+// nginx only takes a whole burst multiplier, so there Burst is rounded up to a
+// multiple of RequestsPerSecond.
+type IngressRateLimit struct {
+	RequestsPerSecond *float64
+	Burst             *float64
+	Connections       *float64
+}
+
+type IngressStickySessions struct {
+	CookieName *string
+	MaxAge     *float64
+}
+
+// NginxIngressOptions are rendered to ingress-nginx annotations.
+// Timeouts are in seconds, ProxyBodySize uses nginx units such as "8m".
+type NginxIngressOptions struct {
+	ProxyBodySize     *string
+	ConnectTimeout    *float64
+	ReadTimeout       *float64
+	SendTimeout       *float64
+	RewriteTarget     *string
+	SslRedirect       *bool
+	ForceSslRedirect  *bool
+	Cors              *IngressCors
+	RateLimit         *IngressRateLimit
+	AllowSourceRanges *[]*string
+	BackendProtocol   *string
+	WebSocket         *bool
+	StickySessions    *IngressStickySessions
+}
+
+// TraefikIngressOptions are rendered to Traefik router and service annotations.
+// Features Traefik only offers as middlewares are created as Middleware objects
+// and attached to the router. Timeouts are in seconds.
+type TraefikIngressOptions struct {
+	EntryPoints         *[]*string
+	Middlewares         *[]*string
+	MaxRequestBodyBytes *float64
+	ConnectTimeout      *float64
+	ReadTimeout         *float64
+	IdleTimeout         *float64
+	RewriteRegex        *string
+	RewriteTarget       *string
+	SslRedirect         *bool
+	Cors                *IngressCors
+	RateLimit           *IngressRateLimit
+	AllowSourceRanges   *[]*string
+	BackendProtocol     *string
+	StickySessions      *IngressStickySessions
+}
+
+const nginxPrefix = "nginx.ingress.kubernetes.io/"
+
+const traefikPrefix = "traefik.ingress.kubernetes.io/"
+
+func (options *NginxIngressOptions) annotations() map[string]*string {
+
+	annotations := make(map[string]*string)
+
+	// Копии, чтобы не менять параметры вызывающего кода
+	readTimeout := options.ReadTimeout
+	sendTimeout := options.SendTimeout
+
+	if options.WebSocket != nil && *options.WebSocket {
+		if readTimeout == nil {
+			readTimeout = jsii.Number(3600)
+		}
+		if sendTimeout == nil {
+			sendTimeout = jsii.Number(3600)
+		}
+	}
+
+	setString(annotations, nginxPrefix+"proxy-body-size", options.ProxyBodySize)
+	setNumber(annotations, nginxPrefix+"proxy-connect-timeout", options.ConnectTimeout)
+	setNumber(annotations, nginxPrefix+"proxy-read-timeout", readTimeout)
+	setNumber(annotations, nginxPrefix+"proxy-send-timeout", sendTimeout)
+	setString(annotations, nginxPrefix+"rewrite-target", options.RewriteTarget)
+	setBool(annotations, nginxPrefix+"ssl-redirect", options.SslRedirect)
+	setBool(annotations, nginxPrefix+"force-ssl-redirect", options.ForceSslRedirect)
+	setList(annotations, nginxPrefix+"whitelist-source-range", options.AllowSourceRanges)
+	setString(annotations, nginxPrefix+"backend-protocol", options.BackendProtocol)
+
+	if options.Cors != nil {
+		annotations[nginxPrefix+"enable-cors"] = jsii.String("true")
+		setList(annotations, nginxPrefix+"cors-allow-origin", options.Cors.AllowOrigins)
+		setList(annotations, nginxPrefix+"cors-allow-methods", options.Cors.AllowMethods)
+		setList(annotations, nginxPrefix+"cors-allow-headers", options.Cors.AllowHeaders)
+		setBool(annotations, nginxPrefix+"cors-allow-credentials", options.Cors.AllowCredentials)
+		setNumber(annotations, nginxPrefix+"cors-max-age", options.Cors.MaxAge)
+	}
+
+	if options.RateLimit != nil {
+		setNumber(annotations, nginxPrefix+"limit-rps", options.RateLimit.RequestsPerSecond)
+		if options.RateLimit.Burst != nil && options.RateLimit.RequestsPerSecond != nil {
+			setNumber(annotations, nginxPrefix+"limit-burst-multiplier",
+				jsii.Number(math.Ceil(*options.RateLimit.Burst / *options.RateLimit.RequestsPerSecond)))
+		}
+		setNumber(annotations, nginxPrefix+"limit-connections", options.RateLimit.Connections)
+	}
+
+	if options.StickySessions != nil {
+		annotations[nginxPrefix+"affinity"] = jsii.String("cookie")
+		setString(annotations, nginxPrefix+"session-cookie-name", options.StickySessions.CookieName)
+		setNumber(annotations, nginxPrefix+"session-cookie-max-age", options.StickySessions.MaxAge)
+	}
+
+	return annotations
+}
+
+// render creates the Middleware and ServersTransport objects the options need
+// and returns the annotations for the Ingress and for the frontend Service.
+func (options *TraefikIngressOptions) render(
+	scope constructs.Construct,
+	id string,
+) (map[string]*string, map[string]*string) {
+
+	ingressAnnotations := make(map[string]*string)
+	serviceAnnotations := make(map[string]*string)
+
+	namespace := "default"
+	if ns := cdk8s.Chart_Of(scope).Namespace(); ns != nil {
+		namespace = *ns
+	}
+
+	reference := func(name string) string {
+		return fmt.Sprintf("%s-%s@kubernetescrd", namespace, name)
+	}
+
+	middlewares := []string{}
+
+	addMiddleware := func(feature string, spec map[string]interface{}) {
+		name := fmt.Sprintf("%s-%s", id, feature)
+		cdk8s.NewApiObject(scope, jsii.String(fmt.Sprintf("middleware-%s", feature)), &cdk8s.ApiObjectProps{
+			ApiVersion: jsii.String("traefik.io/v1alpha1"),
+			Kind:       jsii.String("Middleware"),
+			Metadata: &cdk8s.ApiObjectMetadata{
+				Name: jsii.String(name),
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+		}).AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String("/spec"), &spec))
+		middlewares = append(middlewares, reference(name))
+	}
+
+	if options.SslRedirect != nil && *options.SslRedirect {
+		addMiddleware("redirect", map[string]interface{}{
+			"redirectScheme": map[string]interface{}{
+				"scheme":    "https",
+				"permanent": true,
+			},
+		})
+	}
+	if options.AllowSourceRanges != nil {
+		addMiddleware("allowlist", map[string]interface{}{
+			"ipAllowList": map[string]interface{}{
+				"sourceRange": options.AllowSourceRanges,
+			},
+		})
+	}
+	if options.RateLimit != nil && options.RateLimit.RequestsPerSecond != nil {
+		rateLimit := map[string]interface{}{
+			"average": options.RateLimit.RequestsPerSecond,
+		}
+		if options.RateLimit.Burst != nil {
+			rateLimit["burst"] = options.RateLimit.Burst
+		}
+		addMiddleware("ratelimit", map[string]interface{}{
+			"rateLimit": rateLimit,
+		})
+	}
+	if options.RateLimit != nil && options.RateLimit.Connections != nil {
+		addMiddleware("inflight", map[string]interface{}{
+			"inFlightReq": map[string]interface{}{
+				"amount": options.RateLimit.Connections,
+			},
+		})
+	}
+	if options.MaxRequestBodyBytes != nil {
+		addMiddleware("buffering", map[string]interface{}{
+			"buffering": map[string]interface{}{
+				"maxRequestBodyBytes": options.MaxRequestBodyBytes,
+			},
+		})
+	}
+	if options.Cors != nil {
+		headers := map[string]interface{}{}
+		setSpec(headers, "accessControlAllowOriginList", options.Cors.AllowOrigins)
+		setSpec(headers, "accessControlAllowMethods", options.Cors.AllowMethods)
+		setSpec(headers, "accessControlAllowHeaders", options.Cors.AllowHeaders)
+		setSpec(headers, "accessControlAllowCredentials", options.Cors.AllowCredentials)
+		setSpec(headers, "accessControlMaxAge", options.Cors.MaxAge)
+		addMiddleware("cors", map[string]interface{}{
+			"headers": headers,
+		})
+	}
+	if options.RewriteTarget != nil {
+		regex := options.RewriteRegex
+		if regex == nil {
+			regex = jsii.String("^/(.*)")
+		}
+		addMiddleware("rewrite", map[string]interface{}{
+			"replacePathRegex": map[string]interface{}{
+				"regex":       regex,
+				"replacement": options.RewriteTarget,
+			},
+		})
+	}
+
+	if options.Middlewares != nil {
+		for _, middleware := range *options.Middlewares {
+			middlewares = append(middlewares, *middleware)
+		}
+	}
+
+	if len(middlewares) > 0 {
+		ingressAnnotations[traefikPrefix+"router.middlewares"] = jsii.String(strings.Join(middlewares, ","))
+	}
+	setList(ingressAnnotations, traefikPrefix+"router.entrypoints", options.EntryPoints)
+
+	if options.ConnectTimeout != nil || options.ReadTimeout != nil || options.IdleTimeout != nil {
+		timeouts := map[string]interface{}{}
+		setDuration(timeouts, "dialTimeout", options.ConnectTimeout)
+		setDuration(timeouts, "responseHeaderTimeout", options.ReadTimeout)
+		setDuration(timeouts, "idleConnTimeout", options.IdleTimeout)
+
+		name := fmt.Sprintf("%s-transport", id)
+		spec := map[string]interface{}{
+			"forwardingTimeouts": timeouts,
+		}
+		cdk8s.NewApiObject(scope, jsii.String("servers-transport"), &cdk8s.ApiObjectProps{
+			ApiVersion: jsii.String("traefik.io/v1alpha1"),
+			Kind:       jsii.String("ServersTransport"),
+			Metadata: &cdk8s.ApiObjectMetadata{
+				Name: jsii.String(name),
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+		}).AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String("/spec"), &spec))
+		serviceAnnotations[traefikPrefix+"service.serverstransport"] = jsii.String(reference(name))
+	}
+
+	setString(serviceAnnotations, traefikPrefix+"service.serversscheme", options.BackendProtocol)
+
+	if options.StickySessions != nil {
+		serviceAnnotations[traefikPrefix+"service.sticky.cookie"] = jsii.String("true")
+		setString(serviceAnnotations, traefikPrefix+"service.sticky.cookie.name", options.StickySessions.CookieName)
+		setNumber(serviceAnnotations, traefikPrefix+"service.sticky.cookie.maxage", options.StickySessions.MaxAge)
+	}
+
+	return ingressAnnotations, serviceAnnotations
+}
+
+func newIngress(
+	scope constructs.Construct,
+	id string,
+	props *FrontendProps,
+	service cdk8splus28.Service,
+	routes []*route,
+) cdk8splus28.Ingress {
+
+	rules := []*cdk8splus28.IngressRule{}
+
+	for _, route := range routes {
+		rules = append(rules, &cdk8splus28.IngressRule{
+			Host: route.host,
+			Path: route.path,
+			Backend: cdk8splus28.IngressBackend_FromService(route.service, &cdk8splus28.ServiceIngressBackendOptions{
+				Port: route.port,
+			}),
+			PathType: route.pathType,
+		})
+	}
+
+	var tls *[]*cdk8splus28.IngressTls
+
+	if props.Tls.Mode != TlsMode_NONE {
+		tlsHosts := routedHosts(routes)
+		tls = &[]*cdk8splus28.IngressTls{
+			{
+				Hosts:  &tlsHosts,
+				Secret: cdk8splus28.Secret_FromSecretName(scope, &id, props.Tls.SecretName),
+			},
+		}
+	}
+
+	annotations := make(map[string]*string)
+
+	if props.ClusterIssuer != nil && props.Tls.Mode == TlsMode_CERT_MANAGER {
+		annotations["cert-manager.io/cluster-issuer"] = props.ClusterIssuer
+	}
+
+	if props.Nginx != nil {
+		for k, v := range props.Nginx.annotations() {
+			annotations[k] = v
+		}
+	}
+
+	if props.Traefik != nil {
+		ingressAnnotations, serviceAnnotations := props.Traefik.render(scope, id)
+		for k, v := range ingressAnnotations {
+			annotations[k] = v
+		}
+		for k, v := range serviceAnnotations {
+			service.Metadata().AddAnnotation(jsii.String(k), v)
+		}
+	}
+
+	if props.Annotations != nil {
+		for k, v := range *props.Annotations {
+			annotations[k] = v
+		}
+	}
+
+	return cdk8splus28.NewIngress(scope, jsii.String("ingress"), &cdk8splus28.IngressProps{
+		Metadata: &cdk8s.ApiObjectMetadata{
+			Labels: &map[string]*string{
+				"io.service": jsii.String(id),
+			},
+			Annotations: &annotations,
+		},
+		ClassName: props.IngressClassName,
+		Rules:     &rules,
+		Tls:       tls,
+	})
+}
+
+func setString(annotations map[string]*string, key string, value *string) {
+	if value != nil {
+		annotations[key] = value
+	}
+}
+
+func setNumber(annotations map[string]*string, key string, value *float64) {
+	if value != nil {
+		annotations[key] = jsii.String(strconv.FormatFloat(*value, 'f', -1, 64))
+	}
+}
+
+func setBool(annotations map[string]*string, key string, value *bool) {
+	if value != nil {
+		annotations[key] = jsii.String(fmt.Sprintf("%t", *value))
+	}
+}
+
+func setList(annotations map[string]*string, key string, values *[]*string) {
+	if values != nil {
+		items := []string{}
+		for _, value := range *values {
+			items = append(items, *value)
+		}
+		annotations[key] = jsii.String(strings.Join(items, ","))
+	}
+}
+
+func setSpec[T any](spec map[string]interface{}, key string, value *T) {
+	if value != nil {
+		spec[key] = value
+	}
+}
+
+func setDuration(spec map[string]interface{}, key string, seconds *float64) {
+	if seconds != nil {
+		spec[key] = strconv.FormatFloat(*seconds, 'f', -1, 64) + "s"
+	}
+}
@@ -0,0 +1,156 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func newTestFrontend(chart cdk8s.Chart, props *FrontendProps) FrontendResource {
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	return NewFrontend(chart, "web", jsii.String("example.com"), jsii.String("nginx:1.25"), props)
+}
+
+func checkAnnotations(t *testing.T, manifest map[string]interface{}, expected map[string]string) {
+
+	t.Helper()
+
+	for key, value := range expected {
+		if actual := synth.Field(manifest, "metadata", "annotations", key); actual != value {
+			t.Errorf("%s: expected %q, got %v", key, value, actual)
+		}
+	}
+}
+
+func TestNginxIngressAnnotations(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestFrontend(chart, &FrontendProps{
+		Nginx: &NginxIngressOptions{
+			ProxyBodySize:  jsii.String("8m"),
+			ConnectTimeout: jsii.Number(1000000),
+			SslRedirect:    jsii.Bool(false),
+			WebSocket:      jsii.Bool(true),
+			RateLimit: &IngressRateLimit{
+				RequestsPerSecond: jsii.Number(10),
+				Burst:             jsii.Number(15),
+				Connections:       jsii.Number(20),
+			},
+			Cors: &IngressCors{
+				AllowOrigins: &[]*string{jsii.String("https://a.example.com"), jsii.String("https://b.example.com")},
+				MaxAge:       jsii.Number(600),
+			},
+			AllowSourceRanges: &[]*string{jsii.String("10.0.0.0/8")},
+		},
+		Annotations: &map[string]*string{
+			"nginx.ingress.kubernetes.io/proxy-body-size": jsii.String("16m"),
+		},
+	})
+
+	ingress := synth.Find(t, synth.Manifests(app), "Ingress")
+
+	checkAnnotations(t, ingress, map[string]string{
+		"nginx.ingress.kubernetes.io/proxy-body-size":        "16m",
+		"nginx.ingress.kubernetes.io/proxy-connect-timeout":  "1000000",
+		"nginx.ingress.kubernetes.io/proxy-read-timeout":     "3600",
+		"nginx.ingress.kubernetes.io/proxy-send-timeout":     "3600",
+		"nginx.ingress.kubernetes.io/ssl-redirect":           "false",
+		"nginx.ingress.kubernetes.io/limit-rps":              "10",
+		"nginx.ingress.kubernetes.io/limit-burst-multiplier": "2",
+		"nginx.ingress.kubernetes.io/limit-connections":      "20",
+		"nginx.ingress.kubernetes.io/enable-cors":            "true",
+		"nginx.ingress.kubernetes.io/cors-allow-origin":      "https://a.example.com,https://b.example.com",
+		"nginx.ingress.kubernetes.io/cors-max-age":           "600",
+		"nginx.ingress.kubernetes.io/whitelist-source-range": "10.0.0.0/8",
+	})
+}
+
+func TestTraefikIngressAnnotations(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestFrontend(chart, &FrontendProps{
+		Traefik: &TraefikIngressOptions{
+			EntryPoints:     &[]*string{jsii.String("websecure")},
+			Middlewares:     &[]*string{jsii.String("auth-basic@kubernetescrd")},
+			ReadTimeout:     jsii.Number(1000000),
+			BackendProtocol: jsii.String("h2c"),
+			RateLimit: &IngressRateLimit{
+				RequestsPerSecond: jsii.Number(10),
+				Burst:             jsii.Number(15),
+			},
+			StickySessions: &IngressStickySessions{
+				CookieName: jsii.String("route"),
+			},
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	checkAnnotations(t, synth.Find(t, manifests, "Ingress"), map[string]string{
+		"traefik.ingress.kubernetes.io/router.entrypoints": "websecure",
+		"traefik.ingress.kubernetes.io/router.middlewares": "apps-web-ratelimit@kubernetescrd,auth-basic@kubernetescrd",
+	})
+
+	checkAnnotations(t, synth.Find(t, manifests, "Service"), map[string]string{
+		"traefik.ingress.kubernetes.io/service.serverstransport":   "apps-web-transport@kubernetescrd",
+		"traefik.ingress.kubernetes.io/service.serversscheme":      "h2c",
+		"traefik.ingress.kubernetes.io/service.sticky.cookie":      "true",
+		"traefik.ingress.kubernetes.io/service.sticky.cookie.name": "route",
+	})
+
+	middleware := synth.Find(t, manifests, "Middleware")
+
+	if name := synth.Field(middleware, "metadata", "name"); name != "web-ratelimit" {
+		t.Errorf("expected the web-ratelimit middleware, got %v", name)
+	}
+	if average := synth.Field(middleware, "spec", "rateLimit", "average"); average != float64(10) {
+		t.Errorf("expected an average of 10, got %v", average)
+	}
+	if burst := synth.Field(middleware, "spec", "rateLimit", "burst"); burst != float64(15) {
+		t.Errorf("expected a burst of 15, got %v", burst)
+	}
+
+	transport := synth.Find(t, manifests, "ServersTransport")
+
+	if timeout := synth.Field(transport, "spec", "forwardingTimeouts", "responseHeaderTimeout"); timeout != "1000000s" {
+		t.Errorf("expected a 1000000s timeout, got %v", timeout)
+	}
+}
+
+func TestNginxWebSocketKeepsOptions(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	options := &NginxIngressOptions{WebSocket: jsii.Bool(true)}
+
+	newTestFrontend(chart, &FrontendProps{Nginx: options})
+
+	checkAnnotations(t, synth.Find(t, synth.Manifests(app), "Ingress"), map[string]string{
+		nginxPrefix + "proxy-read-timeout": "3600",
+		nginxPrefix + "proxy-send-timeout": "3600",
+	})
+
+	if options.ReadTimeout != nil || options.SendTimeout != nil {
+		t.Error("expected the WebSocket timeouts to leave the options unchanged")
+	}
+}
+
+func TestIngressRejectsBothControllers(t *testing.T) {
+
+	_, chart := synth.NewChart("apps")
+
+	if synth.Panics(func() {
+		newTestFrontend(chart, &FrontendProps{
+			Nginx:   &NginxIngressOptions{},
+			Traefik: &TraefikIngressOptions{},
+		})
+	}) == nil {
+		t.Error("expected a panic for both Nginx and Traefik options")
+	}
+}
@@ -0,0 +1,78 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendCommand(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		Command:    &[]*string{jsii.String("/app/server")},
+		Args:       &[]*string{jsii.String("--verbose")},
+		WorkingDir: jsii.String("/app"),
+	})
+
+	container := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	if command := synth.Field(container, "command", 0); command != "/app/server" {
+		t.Errorf("expected the /app/server command, got %v", command)
+	}
+	if arg := synth.Field(container, "args", 0); arg != "--verbose" {
+		t.Errorf("expected the --verbose argument, got %v", arg)
+	}
+	if workingDir := synth.Field(container, "workingDir"); workingDir != "/app" {
+		t.Errorf("expected the /app working directory, got %v", workingDir)
+	}
+}
+
+func TestBackendLifecycle(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		PostStart:                cdk8splus28.Handler_FromCommand(&[]*string{jsii.String("/app/warmup")}),
+		PreStop:                  containers.Handler_FromSleep(cdk8s.Duration_Seconds(jsii.Number(5))),
+		TerminationGracePeriod:   cdk8s.Duration_Seconds(jsii.Number(45)),
+		TerminationMessagePolicy: jsii.String("FallbackToLogsOnError"),
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+	container := synth.Field(spec, "containers", 0)
+
+	if command := synth.Field(container, "lifecycle", "postStart", "exec", "command", 0); command != "/app/warmup" {
+		t.Errorf("expected the /app/warmup postStart hook, got %v", command)
+	}
+	if seconds := synth.Field(container, "lifecycle", "preStop", "exec", "command", 1); seconds != "5" {
+		t.Errorf("expected a 5 second preStop pause, got %v", seconds)
+	}
+	if policy := synth.Field(container, "terminationMessagePolicy"); policy != "FallbackToLogsOnError" {
+		t.Errorf("expected FallbackToLogsOnError, got %v", policy)
+	}
+	if grace := synth.Field(spec, "terminationGracePeriodSeconds"); grace != float64(45) {
+		t.Errorf("expected a 45 second grace period, got %v", grace)
+	}
+}
+
+func TestBackendWithoutLifecycle(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{})
+
+	container := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	if lifecycle := synth.Field(container, "lifecycle"); lifecycle != nil {
+		t.Errorf("expected no lifecycle without hooks, got %v", lifecycle)
+	}
+	if policy := synth.Field(container, "terminationMessagePolicy"); policy != nil {
+		t.Errorf("expected the default termination message policy, got %v", policy)
+	}
+}
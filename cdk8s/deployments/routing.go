@@ -0,0 +1,100 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+)
+
+// FrontendRoute is one entry of the Frontend routing table. Hosts defaults to
+// the frontend host and its aliases, Path to "/" with Prefix matching and
+// Service to the frontend's own service on its ingress port.
+type FrontendRoute struct {
+	Hosts    *[]*string
+	Path     *string
+	PathType cdk8splus28.HttpIngressPathType
+	Service  cdk8splus28.Service
+	Port     *float64
+}
+
+type route struct {
+	host     *string
+	path     *string
+	pathType cdk8splus28.HttpIngressPathType
+	service  cdk8splus28.Service
+	port     *float64
+}
+
+func (props *FrontendProps) hosts(host *string) []*string {
+
+	hosts := []*string{host}
+
+	if props.Aliases != nil {
+		hosts = append(hosts, *props.Aliases...)
+	}
+
+	return hosts
+}
+
+func (props *FrontendProps) routes(host *string, service cdk8splus28.Service, port *float64) []*route {
+
+	frontendRoutes := []*FrontendRoute{{}}
+
+	if props.Routes != nil {
+		frontendRoutes = *props.Routes
+	}
+
+	routes := []*route{}
+
+	for _, frontendRoute := range frontendRoutes {
+
+		hosts := props.hosts(host)
+		if frontendRoute.Hosts != nil {
+			hosts = *frontendRoute.Hosts
+		}
+
+		path := frontendRoute.Path
+		if path == nil {
+			path = jsii.String("/")
+		}
+
+		pathType := frontendRoute.PathType
+		if pathType == "" {
+			pathType = cdk8splus28.HttpIngressPathType_PREFIX
+		}
+
+		routeService := service
+		routePort := port
+		if frontendRoute.Service != nil {
+			routeService = frontendRoute.Service
+			routePort = frontendRoute.Port
+		}
+
+		for _, routeHost := range hosts {
+			routes = append(routes, &route{
+				host:     routeHost,
+				path:     path,
+				pathType: pathType,
+				service:  routeService,
+				port:     routePort,
+			})
+		}
+	}
+
+	return routes
+}
+
+// routedHosts returns every distinct host of the routing table, in order.
+func routedHosts(routes []*route) []*string {
+
+	hosts := []*string{}
+	seen := make(map[string]bool)
+
+	for _, route := range routes {
+		if route.host != nil && !seen[*route.host] {
+			seen[*route.host] = true
+			hosts = append(hosts, route.host)
+		}
+	}
+
+	return hosts
+}
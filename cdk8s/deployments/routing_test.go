@@ -0,0 +1,88 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestIngressRoutingTable(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	api := newTestBackend(constructs.NewConstruct(chart, jsii.String("api")), &BackendProps{})
+
+	newTestFrontend(chart, &FrontendProps{
+		Aliases:       &[]*string{jsii.String("www.example.com")},
+		ClusterIssuer: jsii.String("letsencrypt"),
+		Routes: &[]*FrontendRoute{
+			{},
+			{
+				Path:    jsii.String("/api"),
+				Service: api.Service,
+				Port:    jsii.Number(80),
+			},
+			{
+				Hosts:    &[]*string{jsii.String("status.example.com")},
+				Path:     jsii.String("/health"),
+				PathType: cdk8splus28.HttpIngressPathType_EXACT,
+			},
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	ingress := synth.Find(t, manifests, "Ingress")
+
+	paths := map[string]map[string]interface{}{}
+
+	for _, rule := range synth.Field(ingress, "spec", "rules").([]interface{}) {
+		for _, path := range synth.Field(rule, "http", "paths").([]interface{}) {
+			paths[synth.Field(rule, "host").(string)+synth.Field(path, "path").(string)] = path.(map[string]interface{})
+		}
+	}
+
+	if len(paths) != 5 {
+		t.Errorf("expected five host paths, got %v", paths)
+	}
+
+	services := map[string]string{}
+	for _, service := range synth.All(manifests, "Service") {
+		services[synth.Field(service, "metadata", "labels", "io.service").(string)] = synth.Field(service, "metadata", "name").(string)
+	}
+
+	expected := []struct {
+		path     string
+		pathType string
+		service  string
+	}{
+		{"example.com/", "Prefix", services["web"]},
+		{"www.example.com/", "Prefix", services["web"]},
+		{"example.com/api", "Prefix", services["api"]},
+		{"www.example.com/api", "Prefix", services["api"]},
+		{"status.example.com/health", "Exact", services["web"]},
+	}
+
+	for _, route := range expected {
+		path, ok := paths[route.path]
+		if !ok {
+			t.Errorf("%s: expected a path", route.path)
+			continue
+		}
+		if pathType := synth.Field(path, "pathType"); pathType != route.pathType {
+			t.Errorf("%s: expected %s matching, got %v", route.path, route.pathType, pathType)
+		}
+		if service := synth.Field(path, "backend", "service", "name"); service != route.service {
+			t.Errorf("%s: expected the %s service, got %v", route.path, route.service, service)
+		}
+	}
+
+	hosts := synth.Field(ingress, "spec", "tls", 0, "hosts").([]interface{})
+
+	if len(hosts) != 3 || hosts[0] != "example.com" || hosts[1] != "www.example.com" || hosts[2] != "status.example.com" {
+		t.Errorf("expected the TLS hosts of the routing table, got %v", hosts)
+	}
+}
@@ -0,0 +1,90 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendRestrictedProfile(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		Sidecars: &[]*containers.ContainerProps{
+			{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30"), Native: jsii.Bool(true)},
+		},
+		SecurityProfile: &pods.SecurityProfile{
+			WritablePaths: &[]*string{jsii.String("/var/cache/nginx")},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+
+	pod := map[string]interface{}{
+		"runAsNonRoot": true,
+		"runAsUser":    float64(1000),
+		"runAsGroup":   float64(1000),
+		"fsGroup":      float64(1000),
+	}
+
+	for key, value := range pod {
+		if actual := synth.Field(spec, "securityContext", key); actual != value {
+			t.Errorf("pod %s: expected %v, got %v", key, value, actual)
+		}
+	}
+	if seccomp := synth.Field(spec, "securityContext", "seccompProfile", "type"); seccomp != "RuntimeDefault" {
+		t.Errorf("expected the RuntimeDefault seccomp profile, got %v", seccomp)
+	}
+
+	volume := synth.Field(spec, "volumes", 0)
+	if synth.Field(volume, "name") != "writable-var-cache-nginx" || synth.Field(volume, "emptyDir") == nil {
+		t.Errorf("expected an emptyDir for the writable path, got %v", volume)
+	}
+
+	for _, key := range []string{"containers", "initContainers"} {
+		container := synth.Field(spec, key, 0)
+		if readOnly := synth.Field(container, "securityContext", "readOnlyRootFilesystem"); readOnly != true {
+			t.Errorf("%s: expected a read-only root filesystem, got %v", key, readOnly)
+		}
+		if escalation := synth.Field(container, "securityContext", "allowPrivilegeEscalation"); escalation != false {
+			t.Errorf("%s: expected no privilege escalation, got %v", key, escalation)
+		}
+		if drop := synth.Field(container, "securityContext", "capabilities", "drop", 0); drop != "ALL" {
+			t.Errorf("%s: expected every capability dropped, got %v", key, drop)
+		}
+		if path := synth.Field(container, "volumeMounts", 0, "mountPath"); path != "/var/cache/nginx" {
+			t.Errorf("%s: expected the writable path mounted, got %v", key, path)
+		}
+	}
+}
+
+func TestBackendProfileOverrides(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	newTestBackend(chart, &BackendProps{
+		SecurityProfile: &pods.SecurityProfile{
+			Level: pods.SecurityLevel_BASELINE,
+			User:  jsii.Number(2000),
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+
+	if user := synth.Field(spec, "securityContext", "runAsUser"); user != float64(2000) {
+		t.Errorf("expected the overridden user, got %v", user)
+	}
+	if seccomp := synth.Field(spec, "securityContext", "seccompProfile", "type"); seccomp != "RuntimeDefault" {
+		t.Errorf("expected the RuntimeDefault seccomp profile, got %v", seccomp)
+	}
+	if readOnly := synth.Field(spec, "containers", 0, "securityContext", "readOnlyRootFilesystem"); readOnly != false {
+		t.Errorf("expected a writable root filesystem at baseline, got %v", readOnly)
+	}
+	if volumes := synth.Field(spec, "volumes"); volumes != nil {
+		t.Errorf("expected no writable volumes at baseline, got %v", volumes)
+	}
+}
@@ -0,0 +1,102 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestBackendServiceAccount(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestBackend(chart, &BackendProps{
+		ServiceAccount: &pods.ServiceAccountProps{
+			Rules: &[]*pods.AccessRule{
+				{
+					ApiGroups: &[]*string{jsii.String("coordination.k8s.io")},
+					Resources: &[]*string{jsii.String("leases")},
+					Verbs:     &[]*string{jsii.String("get"), jsii.String("update")},
+				},
+				{
+					Resources:     &[]*string{jsii.String("configmaps")},
+					Verbs:         &[]*string{jsii.String("get")},
+					ResourceNames: &[]*string{jsii.String("settings")},
+				},
+			},
+			ClusterRules: &[]*pods.AccessRule{
+				{Resources: &[]*string{jsii.String("nodes")}, Verbs: &[]*string{jsii.String("list")}},
+			},
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	account := synth.Field(synth.Find(t, manifests, "ServiceAccount"), "metadata", "name")
+	role := synth.Find(t, manifests, "Role")
+	clusterRole := synth.Find(t, manifests, "ClusterRole")
+
+	if group := synth.Field(role, "rules", 1, "apiGroups", 0); group != "" {
+		t.Errorf("expected the core group by default, got %v", group)
+	}
+	if name := synth.Field(role, "rules", 1, "resourceNames", 0); name != "settings" {
+		t.Errorf("expected the rule narrowed to settings, got %v", name)
+	}
+
+	bindings := map[string]map[string]interface{}{
+		"RoleBinding":        synth.Find(t, manifests, "RoleBinding"),
+		"ClusterRoleBinding": synth.Find(t, manifests, "ClusterRoleBinding"),
+	}
+	roles := map[string]interface{}{
+		"RoleBinding":        synth.Field(role, "metadata", "name"),
+		"ClusterRoleBinding": synth.Field(clusterRole, "metadata", "name"),
+	}
+
+	for kind, binding := range bindings {
+		if name := synth.Field(binding, "roleRef", "name"); name != roles[kind] {
+			t.Errorf("%s: expected the role %v, got %v", kind, roles[kind], name)
+		}
+		if name := synth.Field(binding, "subjects", 0, "name"); name != account {
+			t.Errorf("%s: expected the account %v, got %v", kind, account, name)
+		}
+		if namespace := synth.Field(binding, "subjects", 0, "namespace"); namespace != "apps" {
+			t.Errorf("%s: expected the account namespace, got %v", kind, namespace)
+		}
+	}
+
+	if namespace := synth.Field(clusterRole, "metadata", "namespace"); namespace != nil {
+		t.Errorf("expected a cluster-scoped ClusterRole, got namespace %v", namespace)
+	}
+
+	spec := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "template", "spec")
+
+	if name := synth.Field(spec, "serviceAccountName"); name != account {
+		t.Errorf("expected the pods to run as %v, got %v", account, name)
+	}
+	if automount := synth.Field(spec, "automountServiceAccountToken"); automount != true {
+		t.Errorf("expected the token mounted for an account with rules, got %v", automount)
+	}
+}
+
+func TestBackendServiceAccountWithoutRules(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestBackend(chart, &BackendProps{
+		ServiceAccount: &pods.ServiceAccountProps{},
+	})
+
+	manifests := synth.Manifests(app)
+
+	for _, kind := range []string{"Role", "RoleBinding", "ClusterRole", "ClusterRoleBinding"} {
+		if objects := synth.All(manifests, kind); len(objects) != 0 {
+			t.Errorf("expected no %s without rules, got %d", kind, len(objects))
+		}
+	}
+
+	if automount := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "template", "spec", "automountServiceAccountToken"); automount != false {
+		t.Errorf("expected no token without rules, got %v", automount)
+	}
+}
@@ -0,0 +1,108 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+)
+
+type TlsMode string
+
+const (
+	// Plain HTTP, no TLS block at all.
+	TlsMode_NONE TlsMode = "NONE"
+	// cert-manager issues "<id>-tls" through the ClusterIssuer annotation.
+	TlsMode_CERT_MANAGER TlsMode = "CERT_MANAGER"
+	// An existing secret, managed outside of the kit.
+	TlsMode_SECRET TlsMode = "SECRET"
+	// A wildcard certificate shared across frontends, see NewWildcardCertificate.
+	TlsMode_WILDCARD TlsMode = "WILDCARD"
+)
+
+// FrontendTls configures HTTPS. Mode defaults to TlsMode_CERT_MANAGER when the
+// frontend has a ClusterIssuer and to TlsMode_NONE otherwise.
+type FrontendTls struct {
+	Mode       TlsMode
+	SecretName *string
+}
+
+func (tls *FrontendTls) defaultProps(id string, clusterIssuer *string) {
+	if tls.Mode == "" {
+		if clusterIssuer != nil {
+			tls.Mode = TlsMode_CERT_MANAGER
+		} else {
+			tls.Mode = TlsMode_NONE
+		}
+	}
+	if tls.Mode == TlsMode_CERT_MANAGER && clusterIssuer == nil {
+		// Без издателя секрет "<id>-tls" никто не создаст
+		panic("Для режима TLS CERT_MANAGER требуется ClusterIssuer")
+	}
+	if tls.SecretName == nil {
+		if tls.Mode == TlsMode_SECRET || tls.Mode == TlsMode_WILDCARD {
+			panic(fmt.Sprintf("Для режима TLS %s требуется SecretName", tls.Mode))
+		}
+		tls.SecretName = jsii.String(fmt.Sprintf("%s-tls", id))
+	}
+}
+
+type WildcardCertificateResource struct {
+	Certificate cdk8s.ApiObject
+	SecretName  *string
+}
+
+type WildcardCertificateProps struct {
+	ClusterIssuer *string
+	SecretName    *string
+}
+
+func (props *WildcardCertificateProps) defaultProps(id string) {
+	if props.ClusterIssuer == nil {
+		panic("Для wildcard-сертификата требуется ClusterIssuer")
+	}
+	if props.SecretName == nil {
+		props.SecretName = jsii.String(fmt.Sprintf("%s-tls", id))
+	}
+}
+
+// NewWildcardCertificate requests a cert-manager certificate for the domain and
+// all of its subdomains. Frontends share it through TlsMode_WILDCARD.
+func NewWildcardCertificate(
+	scope constructs.Construct,
+	id string,
+	domain *string,
+	props *WildcardCertificateProps,
+) WildcardCertificateResource {
+
+	props.defaultProps(id)
+
+	certificate := cdk8s.NewApiObject(scope, jsii.String(id), &cdk8s.ApiObjectProps{
+		ApiVersion: jsii.String("cert-manager.io/v1"),
+		Kind:       jsii.String("Certificate"),
+		Metadata: &cdk8s.ApiObjectMetadata{
+			Name: jsii.String(id),
+		},
+	})
+
+	certificate.AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String("/spec"),
+		&map[string]interface{}{
+			"secretName": props.SecretName,
+			"dnsNames": &[]*string{
+				domain,
+				jsii.String(fmt.Sprintf("*.%s", *domain)),
+			},
+			"issuerRef": &map[string]interface{}{
+				"kind": jsii.String("ClusterIssuer"),
+				"name": props.ClusterIssuer,
+			},
+		},
+	))
+
+	return WildcardCertificateResource{
+		Certificate: certificate,
+		SecretName:  props.SecretName,
+	}
+}
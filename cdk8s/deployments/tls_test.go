@@ -0,0 +1,52 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestTlsDefaultsToNoneWithoutIssuer(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestFrontend(chart, &FrontendProps{})
+
+	ingress := synth.Find(t, synth.Manifests(app), "Ingress")
+
+	if tls := synth.Field(ingress, "spec", "tls"); tls != nil {
+		t.Errorf("expected no TLS block, got %v", tls)
+	}
+}
+
+func TestTlsCertManager(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	newTestFrontend(chart, &FrontendProps{
+		ClusterIssuer: jsii.String("letsencrypt"),
+	})
+
+	ingress := synth.Find(t, synth.Manifests(app), "Ingress")
+
+	if issuer := synth.Field(ingress, "metadata", "annotations", "cert-manager.io/cluster-issuer"); issuer != "letsencrypt" {
+		t.Errorf("expected the letsencrypt issuer, got %v", issuer)
+	}
+	if secret := synth.Field(ingress, "spec", "tls", 0, "secretName"); secret != "web-tls" {
+		t.Errorf("expected the web-tls secret, got %v", secret)
+	}
+}
+
+func TestTlsCertManagerRequiresIssuer(t *testing.T) {
+
+	_, chart := synth.NewChart("apps")
+
+	if synth.Panics(func() {
+		newTestFrontend(chart, &FrontendProps{
+			Tls: &FrontendTls{Mode: TlsMode_CERT_MANAGER},
+		})
+	}) == nil {
+		t.Error("expected a panic for cert-manager TLS without an issuer")
+	}
+}
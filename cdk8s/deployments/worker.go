@@ -0,0 +1,197 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
+)
+
+type WorkerResource struct {
+	Deployment       cdk8splus28.Deployment
+	Autoscaler       cdk8splus28.HorizontalPodAutoscaler
+	DisruptionBudget k8s.KubePodDisruptionBudget
+	ServiceAccount   pods.ServiceAccountResource
+}
+
+type WorkerProps struct {
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Network                  *string
+	Labels                   *recommended.Labels
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	Resources                *cdk8splus28.ContainerResources
+	Autoscaling              *AutoscalingProps
+	Strategy                 cdk8splus28.DeploymentStrategy
+	DisruptionBudget         *DisruptionBudgetProps
+	Liveness                 cdk8splus28.Probe
+	Startup                  cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+}
+
+func (props *WorkerProps) defaultProps() {
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[*string]*cdk8splus28.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+}
+
+func NewWorker(
+	scope constructs.Construct,
+	id string,
+	image *string,
+	props *WorkerProps,
+) WorkerResource {
+
+	props.defaultProps()
+
+	tracker := recommended.Track(scope, id, image, props.Labels)
+
+	container := cdk8splus28.NewContainer(&cdk8splus28.ContainerProps{
+		Name:            jsii.String(id),
+		Image:           image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		WorkingDir:      props.WorkingDir,
+		Resources:       props.Resources,
+		Lifecycle:       containers.Lifecycle(props.PostStart, props.PreStop),
+		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
+			ReadOnlyRootFilesystem: jsii.Bool(false),
+			EnsureNonRoot:          jsii.Bool(false),
+		},
+		Liveness: containers.NativeProbe(props.Liveness),
+		Startup:  containers.NativeProbe(props.Startup),
+	})
+
+	for _, k := range containers.SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
+	}
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
+		container.Mount(path, storage, nil)
+	}
+
+	containers.MountVolumes(container, props.Mounts)
+
+	labels := make(map[string]*string)
+
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	var account pods.ServiceAccountResource
+	var serviceAccount cdk8splus28.IServiceAccount
+	var automountToken *bool
+
+	if props.ServiceAccount != nil {
+		account = pods.NewServiceAccount(scope, "service-account", props.ServiceAccount)
+		serviceAccount = account.ServiceAccount
+		automountToken = props.ServiceAccount.AutomountToken
+	}
+
+	var replicas *float64
+
+	if props.Autoscaling == nil {
+		replicas = jsii.Number(1)
+	}
+
+	deployment := cdk8splus28.NewDeployment(
+		scope,
+		jsii.String("deployment"),
+		&cdk8splus28.DeploymentProps{
+			Replicas:                     replicas,
+			Strategy:                     props.Strategy,
+			TerminationGracePeriod:       props.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
+			SecurityContext: &cdk8splus28.PodSecurityContextProps{
+				EnsureNonRoot: jsii.Bool(false),
+			},
+			PodMetadata: &cdk8s.ApiObjectMetadata{
+				Labels: &labels,
+			},
+		},
+	)
+
+	deployment.AttachContainer(container)
+
+	// У воркера нет порта контейнера, поэтому gRPC проба должна указать свой
+	containers.PatchGrpcProbe(deployment.ApiObject(), "/spec/template/spec/containers/0/livenessProbe", props.Liveness, nil)
+	containers.PatchGrpcProbe(deployment.ApiObject(), "/spec/template/spec/containers/0/startupProbe", props.Startup, nil)
+
+	containers.PatchTerminationMessagePolicy(deployment.ApiObject(), "/spec/template/spec", props.TerminationMessagePolicy)
+
+	containers.ConfigureEnv(deployment, container, props.Environment, props.EnvFrom)
+
+	containers.AttachContainers(deployment, "/spec/template/spec", props.InitContainers, props.Sidecars)
+
+	containers.AttachPullSecrets(deployment, "/spec/template/spec")
+
+	containers.AttachChecksum(deployment, "/spec/template/spec")
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(deployment.ApiObject(), "/spec/template/spec", &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(deployment, "/spec/template/spec")
+	}
+
+	deployment.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		deployment.AddVolume(*(*props.Volumes)[path])
+	}
+
+	var autoscaler cdk8splus28.HorizontalPodAutoscaler
+
+	if props.Autoscaling != nil {
+		autoscaler = newAutoscaler(scope, id, deployment, props.Autoscaling)
+	}
+
+	var disruptionBudget k8s.KubePodDisruptionBudget
+
+	if props.DisruptionBudget != nil {
+		disruptionBudget = newDisruptionBudget(scope, id, deployment, props.DisruptionBudget)
+	}
+
+	tracker.Apply()
+
+	return WorkerResource{
+		Deployment:       deployment,
+		Autoscaler:       autoscaler,
+		DisruptionBudget: disruptionBudget,
+		ServiceAccount:   account,
+	}
+}
@@ -0,0 +1,97 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	volumes "github.com/erritis/cdk8skit/v4/cdk8s/volumes"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestWorkerGrpcProbes(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewWorker(chart, "consumer", jsii.String("consumer:1.0"), &WorkerProps{
+		Liveness: containers.Probe_FromGrpc(nil, &containers.ProbeOptions{Port: jsii.Number(9000)}),
+		Startup:  containers.Probe_FromGrpc(jsii.String("startup"), &containers.ProbeOptions{Port: jsii.Number(9001)}),
+	})
+
+	container := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	if port := synth.Field(container, "livenessProbe", "grpc", "port"); port != float64(9000) {
+		t.Errorf("expected a liveness probe on 9000, got %v", port)
+	}
+	if port := synth.Field(container, "startupProbe", "grpc", "port"); port != float64(9001) {
+		t.Errorf("expected a startup probe on 9001, got %v", port)
+	}
+	if service := synth.Field(container, "startupProbe", "grpc", "service"); service != "startup" {
+		t.Errorf("expected the startup service, got %v", service)
+	}
+}
+
+func TestWorkerGrpcProbeRequiresPort(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	if synth.Panics(func() {
+		NewWorker(chart, "consumer", jsii.String("consumer:1.0"), &WorkerProps{
+			Liveness: containers.Probe_FromGrpc(nil, &containers.ProbeOptions{}),
+		})
+	}) == nil {
+		t.Error("expected a panic for a worker gRPC probe without a port")
+	}
+}
+
+func TestWorkerChecksum(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	secret := volumes.NewSecretVolume(chart, "secret", jsii.String("token"), jsii.String("a"), &volumes.SecretVolumeProps{})
+
+	NewWorker(chart, "consumer", jsii.String("consumer:1.0"), &WorkerProps{
+		Volumes: &map[*string]*cdk8splus28.Volume{
+			jsii.String("/run/secrets/token"): &secret,
+		},
+	})
+
+	deployment := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	if checksum := synth.Field(deployment, "spec", "template", "metadata", "annotations", "checksum/config"); checksum == nil {
+		t.Error("expected the worker pods to carry the checksum of their secret")
+	}
+}
+
+func TestWorkerStableOrder(t *testing.T) {
+
+	render := func() string {
+		app, chart := synth.NewChart("")
+
+		variables := map[*string]*string{}
+		mounts := map[*string]*cdk8splus28.Volume{}
+
+		for i := 0; i < 8; i++ {
+			variables[jsii.String(fmt.Sprintf("VARIABLE_%d", i))] = jsii.String("value")
+			volume := cdk8splus28.Volume_FromEmptyDir(chart, jsii.String(fmt.Sprintf("data-%d", i)), jsii.String(fmt.Sprintf("data-%d", i)), nil)
+			mounts[jsii.String(fmt.Sprintf("/data/%d", i))] = &volume
+		}
+
+		NewWorker(chart, "consumer", jsii.String("consumer:1.0"), &WorkerProps{
+			Variables: &variables,
+			Volumes:   &mounts,
+		})
+
+		return *app.SynthYaml()
+	}
+
+	first := render()
+
+	for i := 0; i < 5; i++ {
+		if render() != first {
+			t.Fatal("expected every synth to render the same manifests")
+		}
+	}
+}
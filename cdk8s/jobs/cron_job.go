@@ -0,0 +1,103 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
+)
+
+type CronJobResource struct {
+	CronJob        cdk8splus28.CronJob
+	ServiceAccount pods.ServiceAccountResource
+}
+
+// CronJobProps schedules the job described by Job.
+type CronJobProps struct {
+	Schedule               cdk8s.Cron
+	TimeZone               *string
+	ConcurrencyPolicy      cdk8splus28.ConcurrencyPolicy
+	StartingDeadline       cdk8s.Duration
+	SuccessfulJobsRetained *float64
+	FailedJobsRetained     *float64
+	Suspend                *bool
+	Job                    *JobProps
+}
+
+func (props *CronJobProps) defaultProps() {
+	if props.Schedule == nil {
+		panic("Не указано расписание CronJob")
+	}
+	if props.ConcurrencyPolicy == "" {
+		props.ConcurrencyPolicy = cdk8splus28.ConcurrencyPolicy_FORBID
+	}
+	if props.Job == nil {
+		props.Job = &JobProps{}
+	}
+
+	props.Job.defaultProps()
+}
+
+func NewCronJob(
+	scope constructs.Construct,
+	id string,
+	image *string,
+	props *CronJobProps,
+) CronJobResource {
+
+	props.defaultProps()
+
+	tracker := recommended.Track(scope, id, image, props.Job.Labels)
+
+	labels := map[string]*string{
+		"io.service": jsii.String(id),
+	}
+
+	tracker.Merge(labels)
+
+	if props.Job.Network != nil {
+		labels[*props.Job.Network] = jsii.String("true")
+	}
+
+	account, serviceAccount, automountToken := props.Job.serviceAccount(scope)
+
+	cronJob := cdk8splus28.NewCronJob(
+		scope,
+		jsii.String("cronjob"),
+		&cdk8splus28.CronJobProps{
+			Schedule:                     props.Schedule,
+			TimeZone:                     props.TimeZone,
+			ConcurrencyPolicy:            props.ConcurrencyPolicy,
+			StartingDeadline:             props.StartingDeadline,
+			SuccessfulJobsRetained:       props.SuccessfulJobsRetained,
+			FailedJobsRetained:           props.FailedJobsRetained,
+			Suspend:                      props.Suspend,
+			BackoffLimit:                 props.Job.BackoffLimit,
+			ActiveDeadline:               props.Job.ActiveDeadline,
+			TtlAfterFinished:             props.Job.TtlAfterFinished,
+			RestartPolicy:                props.Job.RestartPolicy,
+			TerminationGracePeriod:       props.Job.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
+			SecurityContext: &cdk8splus28.PodSecurityContextProps{
+				EnsureNonRoot: jsii.Bool(false),
+			},
+			PodMetadata: &cdk8s.ApiObjectMetadata{
+				Labels: &labels,
+			},
+		},
+	)
+
+	props.Job.configure(cronJob, props.Job.container(id, image), "/spec/jobTemplate/spec", labels)
+
+	cronJob.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
+
+	tracker.Apply()
+
+	return CronJobResource{
+		CronJob:        cronJob,
+		ServiceAccount: account,
+	}
+}
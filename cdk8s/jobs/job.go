@@ -0,0 +1,227 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
+)
+
+type JobResource struct {
+	Job            cdk8splus28.Job
+	ServiceAccount pods.ServiceAccountResource
+}
+
+// JobProps renders Sidecars as native sidecars, which stop with the job.
+// A regular sidecar never exits and would keep the job from completing.
+type JobProps struct {
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Network                  *string
+	Labels                   *recommended.Labels
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	Resources                *cdk8splus28.ContainerResources
+	BackoffLimit             *float64
+	ActiveDeadline           cdk8s.Duration
+	TtlAfterFinished         cdk8s.Duration
+	Parallelism              *float64
+	Completions              *float64
+	RestartPolicy            cdk8splus28.RestartPolicy
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+}
+
+func (props *JobProps) defaultProps() {
+	if props.Variables == nil {
+		props.Variables = &map[*string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[*string]*cdk8splus28.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+	if props.RestartPolicy == "" {
+		props.RestartPolicy = cdk8splus28.RestartPolicy_NEVER
+	}
+	if props.Sidecars != nil {
+		for _, sidecar := range *props.Sidecars {
+			// Обычный сайдкар не завершается, и задание с ним никогда не завершилось бы
+			if sidecar.Native == nil {
+				sidecar.Native = jsii.Bool(true)
+			}
+			if !*sidecar.Native {
+				panic("Сайдкары задания должны быть нативными")
+			}
+		}
+	}
+}
+
+func (props *JobProps) container(id string, image *string) cdk8splus28.Container {
+
+	container := cdk8splus28.NewContainer(&cdk8splus28.ContainerProps{
+		Name:            jsii.String(id),
+		Image:           image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		WorkingDir:      props.WorkingDir,
+		Resources:       props.Resources,
+		Lifecycle:       containers.Lifecycle(props.PostStart, props.PreStop),
+		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
+			ReadOnlyRootFilesystem: jsii.Bool(false),
+			EnsureNonRoot:          jsii.Bool(false),
+		},
+	})
+
+	for _, k := range containers.SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
+	}
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
+		container.Mount(path, storage, nil)
+	}
+
+	containers.MountVolumes(container, props.Mounts)
+
+	return container
+}
+
+func (props *JobProps) serviceAccount(scope constructs.Construct) (pods.ServiceAccountResource, cdk8splus28.IServiceAccount, *bool) {
+
+	var account pods.ServiceAccountResource
+
+	if props.ServiceAccount == nil {
+		return account, nil, nil
+	}
+
+	account = pods.NewServiceAccount(scope, "service-account", props.ServiceAccount)
+
+	return account, account.ServiceAccount, props.ServiceAccount.AutomountToken
+}
+
+// configure finishes a job whose spec lives at the given path of its api
+// object, along with the pod template inside it.
+func (props *JobProps) configure(
+	pod cdk8splus28.AbstractPod,
+	container cdk8splus28.Container,
+	jobPath string,
+	labels map[string]*string,
+) {
+
+	path := fmt.Sprintf("%s/template/spec", jobPath)
+
+	pod.AttachContainer(container)
+
+	containers.PatchTerminationMessagePolicy(pod.ApiObject(), path, props.TerminationMessagePolicy)
+
+	containers.ConfigureEnv(pod, container, props.Environment, props.EnvFrom)
+
+	containers.AttachContainers(pod, path, props.InitContainers, props.Sidecars)
+
+	containers.AttachPullSecrets(pod, path)
+
+	containers.AttachChecksum(pod, path)
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(pod.ApiObject(), path, &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(pod, path)
+	}
+
+	if props.Parallelism != nil {
+		pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String(fmt.Sprintf("%s/parallelism", jobPath)),
+			props.Parallelism,
+		))
+	}
+
+	if props.Completions != nil {
+		pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String(fmt.Sprintf("%s/completions", jobPath)),
+			props.Completions,
+		))
+	}
+
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		pod.AddVolume(*(*props.Volumes)[path])
+	}
+}
+
+func NewJob(
+	scope constructs.Construct,
+	id string,
+	image *string,
+	props *JobProps,
+) JobResource {
+
+	props.defaultProps()
+
+	tracker := recommended.Track(scope, id, image, props.Labels)
+
+	labels := map[string]*string{
+		"io.service": jsii.String(id),
+	}
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	account, serviceAccount, automountToken := props.serviceAccount(scope)
+
+	job := cdk8splus28.NewJob(
+		scope,
+		jsii.String("job"),
+		&cdk8splus28.JobProps{
+			BackoffLimit:                 props.BackoffLimit,
+			ActiveDeadline:               props.ActiveDeadline,
+			TtlAfterFinished:             props.TtlAfterFinished,
+			RestartPolicy:                props.RestartPolicy,
+			TerminationGracePeriod:       props.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
+			SecurityContext: &cdk8splus28.PodSecurityContextProps{
+				EnsureNonRoot: jsii.Bool(false),
+			},
+			PodMetadata: &cdk8s.ApiObjectMetadata{
+				Labels: &labels,
+			},
+		},
+	)
+
+	props.configure(job, props.container(id, image), "/spec", labels)
+
+	job.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
+
+	tracker.Apply()
+
+	return JobResource{
+		Job:            job,
+		ServiceAccount: account,
+	}
+}
@@ -0,0 +1,84 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestJobNetwork(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewJob(chart, "migrate", jsii.String("migrate:1.0"), &JobProps{
+		Network: jsii.String("io.network/backend"),
+	})
+
+	job := synth.Find(t, synth.Manifests(app), "Job")
+
+	if label := synth.Field(job, "spec", "template", "metadata", "labels", "io.network/backend"); label != "true" {
+		t.Errorf("expected the pod to join the network, got %v", label)
+	}
+}
+
+func TestCronJobNetwork(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewCronJob(chart, "report", jsii.String("report:1.0"), &CronJobProps{
+		Schedule: cdk8s.Cron_Daily(),
+		Job: &JobProps{
+			Network: jsii.String("io.network/backend"),
+		},
+	})
+
+	cronJob := synth.Find(t, synth.Manifests(app), "CronJob")
+
+	if label := synth.Field(cronJob, "spec", "jobTemplate", "spec", "template", "metadata", "labels", "io.network/backend"); label != "true" {
+		t.Errorf("expected the pod to join the network, got %v", label)
+	}
+}
+
+func TestJobNativeSidecars(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewCronJob(chart, "report", jsii.String("report:1.0"), &CronJobProps{
+		Schedule: cdk8s.Cron_Daily(),
+		Job: &JobProps{
+			Sidecars: &[]*containers.ContainerProps{
+				{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30")},
+			},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "CronJob"), "spec", "jobTemplate", "spec", "template", "spec")
+
+	if count := len(synth.Field(spec, "containers").([]interface{})); count != 1 {
+		t.Errorf("expected the sidecar out of the containers, got %d containers", count)
+	}
+	if name := synth.Field(spec, "initContainers", 0, "name"); name != "proxy" {
+		t.Errorf("expected the proxy sidecar as an init container, got %v", name)
+	}
+	if policy := synth.Field(spec, "initContainers", 0, "restartPolicy"); policy != "Always" {
+		t.Errorf("expected a native sidecar, got restart policy %v", policy)
+	}
+}
+
+func TestJobRejectsRegularSidecars(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	if synth.Panics(func() {
+		NewJob(chart, "migrate", jsii.String("migrate:1.0"), &JobProps{
+			Sidecars: &[]*containers.ContainerProps{
+				{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30"), Native: jsii.Bool(false)},
+			},
+		})
+	}) == nil {
+		t.Error("expected a panic for a regular job sidecar")
+	}
+}
@@ -0,0 +1,81 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+)
+
+const clusterScopedMetadata = "cdk8skit:cluster-scoped"
+
+var clusterScopedKinds = map[string]bool{
+	"APIService":                       true,
+	"CertificateSigningRequest":        true,
+	"ClusterIssuer":                    true,
+	"ClusterRole":                      true,
+	"ClusterRoleBinding":               true,
+	"CSIDriver":                        true,
+	"CSINode":                          true,
+	"CustomResourceDefinition":         true,
+	"FlowSchema":                       true,
+	"GatewayClass":                     true,
+	"IngressClass":                     true,
+	"MutatingWebhookConfiguration":     true,
+	"Namespace":                        true,
+	"Node":                             true,
+	"PersistentVolume":                 true,
+	"PriorityClass":                    true,
+	"PriorityLevelConfiguration":       true,
+	"RuntimeClass":                     true,
+	"StorageClass":                     true,
+	"ValidatingAdmissionPolicy":        true,
+	"ValidatingAdmissionPolicyBinding": true,
+	"ValidatingWebhookConfiguration":   true,
+	"VolumeAttachment":                 true,
+}
+
+type clusterScope struct {
+	chart   cdk8s.Chart
+	patched map[string]bool
+}
+
+// Validate is called right before the chart is rendered, when all its
+// objects exist, and strips the namespace from the cluster-scoped ones.
+func (scope *clusterScope) Validate() *[]*string {
+
+	for _, construct := range *scope.chart.Node().FindAll(constructs.ConstructOrder_PREORDER) {
+		if !*cdk8s.ApiObject_IsApiObject(construct) {
+			continue
+		}
+		object := cdk8s.ApiObject_Of(construct)
+		path := *object.Node().Path()
+		// Синтез может вызываться несколько раз, а патч удаления применим только однажды
+		if scope.patched[path] || !clusterScopedKinds[*object.Kind()] || object.Metadata().Namespace() == nil {
+			continue
+		}
+		object.AddJsonPatch(cdk8s.JsonPatch_Remove(jsii.String("/metadata/namespace")))
+		scope.patched[path] = true
+	}
+
+	return &[]*string{}
+}
+
+// KeepClusterScoped renders the cluster-scoped objects of the chart of the
+// scope, nested charts included, without the namespace of the chart. It may
+// be called any number of times, whatever creates these objects.
+func KeepClusterScoped(scope constructs.Construct) {
+
+	chart := cdk8s.Chart_Of(scope)
+
+	for _, entry := range *chart.Node().Metadata() {
+		if *entry.Type == clusterScopedMetadata {
+			return
+		}
+	}
+
+	chart.Node().AddMetadata(jsii.String(clusterScopedMetadata), jsii.Bool(true), nil)
+	chart.Node().AddValidation(&clusterScope{
+		chart:   chart,
+		patched: map[string]bool{},
+	})
+}
@@ -0,0 +1,238 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type NamespaceResource struct {
+	Chart         cdk8s.Chart
+	Namespace     cdk8splus28.Namespace
+	Quota         k8s.KubeResourceQuota
+	LimitRange    k8s.KubeLimitRange
+	NetworkPolicy cdk8splus28.NetworkPolicy
+	scope         constructs.Construct
+}
+
+// NamespaceQuota caps the total of the namespace. CPU is expressed in
+// millicores, memory in mebibytes and storage in gibibytes.
+type NamespaceQuota struct {
+	CpuRequests    *float64
+	CpuLimits      *float64
+	MemoryRequests *float64
+	MemoryLimits   *float64
+	Storage        *float64
+	Pods           *float64
+	Claims         *float64
+}
+
+func (quota *NamespaceQuota) defaultProps() {
+	if quota.CpuRequests == nil {
+		quota.CpuRequests = jsii.Number(4000)
+	}
+	if quota.CpuLimits == nil {
+		quota.CpuLimits = jsii.Number(8000)
+	}
+	if quota.MemoryRequests == nil {
+		quota.MemoryRequests = jsii.Number(8192)
+	}
+	if quota.MemoryLimits == nil {
+		quota.MemoryLimits = jsii.Number(16384)
+	}
+	if quota.Storage == nil {
+		quota.Storage = jsii.Number(100)
+	}
+	if quota.Pods == nil {
+		quota.Pods = jsii.Number(50)
+	}
+	if quota.Claims == nil {
+		quota.Claims = jsii.Number(20)
+	}
+}
+
+// NamespaceLimits are the requests and limits given to containers that set
+// none, in millicores and mebibytes.
+type NamespaceLimits struct {
+	CpuRequest    *float64
+	CpuLimit      *float64
+	MemoryRequest *float64
+	MemoryLimit   *float64
+}
+
+func (limits *NamespaceLimits) defaultProps() {
+	if limits.CpuRequest == nil {
+		limits.CpuRequest = jsii.Number(100)
+	}
+	if limits.CpuLimit == nil {
+		limits.CpuLimit = jsii.Number(500)
+	}
+	if limits.MemoryRequest == nil {
+		limits.MemoryRequest = jsii.Number(128)
+	}
+	if limits.MemoryLimit == nil {
+		limits.MemoryLimit = jsii.Number(512)
+	}
+}
+
+// NamespaceProps describes a namespace. SecurityLevel, one of privileged,
+// baseline or restricted, is enforced by Pod Security admission, baseline by
+// default; AuditLevel, restricted by default, only warns and audits. DenyAll adds a policy rejecting any ingress traffic
+// not allowed by another policy.
+type NamespaceProps struct {
+	Name          *string
+	Labels        *map[string]*string
+	SecurityLevel *string
+	AuditLevel    *string
+	Quota         *NamespaceQuota
+	Limits        *NamespaceLimits
+	DenyAll       *bool
+}
+
+func (props *NamespaceProps) defaultProps(id string) {
+	if props.Name == nil {
+		props.Name = jsii.String(id)
+	}
+	if props.Labels == nil {
+		props.Labels = &map[string]*string{}
+	}
+	if props.SecurityLevel == nil {
+		props.SecurityLevel = jsii.String("baseline")
+	}
+	if props.AuditLevel == nil {
+		props.AuditLevel = jsii.String("restricted")
+	}
+	if props.Quota == nil {
+		props.Quota = &NamespaceQuota{}
+	}
+	if props.Limits == nil {
+		props.Limits = &NamespaceLimits{}
+	}
+	if props.DenyAll == nil {
+		props.DenyAll = jsii.Bool(false)
+	}
+
+	for _, level := range []*string{props.SecurityLevel, props.AuditLevel} {
+		switch *level {
+		case "privileged", "baseline", "restricted":
+		default:
+			panic(fmt.Sprintf("Неизвестный уровень безопасности: %s", *level))
+		}
+	}
+
+	props.Quota.defaultProps()
+	props.Limits.defaultProps()
+}
+
+func (props *NamespaceProps) labels() *map[string]*string {
+
+	labels := map[string]*string{}
+
+	for key, value := range *props.Labels {
+		labels[key] = value
+	}
+
+	labels["pod-security.kubernetes.io/enforce"] = props.SecurityLevel
+	labels["pod-security.kubernetes.io/enforce-version"] = jsii.String("latest")
+	labels["pod-security.kubernetes.io/audit"] = props.AuditLevel
+	labels["pod-security.kubernetes.io/warn"] = props.AuditLevel
+
+	return &labels
+}
+
+func quantity(value *float64, unit string) k8s.Quantity {
+	return k8s.Quantity_FromString(jsii.String(strconv.FormatFloat(*value, 'f', -1, 64) + unit))
+}
+
+// NewNamespace creates the namespace in a chart of its own. Kit constructs
+// created in this chart, or in charts returned by NewChart, land in the
+// namespace.
+func NewNamespace(scope constructs.Construct, id string, props *NamespaceProps) NamespaceResource {
+
+	props.defaultProps(id)
+
+	chart := cdk8s.NewChart(scope, jsii.String(id), &cdk8s.ChartProps{
+		Namespace: props.Name,
+	})
+
+	KeepClusterScoped(chart)
+
+	namespace := cdk8splus28.NewNamespace(chart, jsii.String("namespace"), &cdk8splus28.NamespaceProps{
+		Metadata: &cdk8s.ApiObjectMetadata{
+			Name:   props.Name,
+			Labels: props.labels(),
+		},
+	})
+
+	quota := k8s.NewKubeResourceQuota(chart, jsii.String("quota"), &k8s.KubeResourceQuotaProps{
+		Spec: &k8s.ResourceQuotaSpec{
+			Hard: &map[string]k8s.Quantity{
+				"requests.cpu":           quantity(props.Quota.CpuRequests, "m"),
+				"limits.cpu":             quantity(props.Quota.CpuLimits, "m"),
+				"requests.memory":        quantity(props.Quota.MemoryRequests, "Mi"),
+				"limits.memory":          quantity(props.Quota.MemoryLimits, "Mi"),
+				"requests.storage":       quantity(props.Quota.Storage, "Gi"),
+				"pods":                   quantity(props.Quota.Pods, ""),
+				"persistentvolumeclaims": quantity(props.Quota.Claims, ""),
+			},
+		},
+	})
+
+	limitRange := k8s.NewKubeLimitRange(chart, jsii.String("limit-range"), &k8s.KubeLimitRangeProps{
+		Spec: &k8s.LimitRangeSpec{
+			Limits: &[]*k8s.LimitRangeItem{
+				{
+					Type: jsii.String("Container"),
+					DefaultRequest: &map[string]k8s.Quantity{
+						"cpu":    quantity(props.Limits.CpuRequest, "m"),
+						"memory": quantity(props.Limits.MemoryRequest, "Mi"),
+					},
+					Default: &map[string]k8s.Quantity{
+						"cpu":    quantity(props.Limits.CpuLimit, "m"),
+						"memory": quantity(props.Limits.MemoryLimit, "Mi"),
+					},
+				},
+			},
+		},
+	})
+
+	var networkPolicy cdk8splus28.NetworkPolicy
+
+	if *props.DenyAll {
+		networkPolicy = cdk8splus28.NewNetworkPolicy(chart, jsii.String("deny-all"), &cdk8splus28.NetworkPolicyProps{
+			Ingress: &cdk8splus28.NetworkPolicyTraffic{
+				Default: cdk8splus28.NetworkPolicyTrafficDefault_DENY,
+			},
+		})
+	}
+
+	return NamespaceResource{
+		Chart:         chart,
+		Namespace:     namespace,
+		Quota:         quota,
+		LimitRange:    limitRange,
+		NetworkPolicy: networkPolicy,
+		scope:         scope,
+	}
+}
+
+// NewChart creates a chart in the namespace, for a kit construct that needs a
+// chart of its own. The chart is a sibling of the namespace chart rendered
+// after it, so the namespace exists before the objects placed into it.
+func (resource NamespaceResource) NewChart(id string) cdk8s.Chart {
+
+	chart := cdk8s.NewChart(resource.scope, jsii.String(id), &cdk8s.ChartProps{
+		Namespace: resource.Namespace.Name(),
+	})
+
+	chart.AddDependency(resource.Chart)
+
+	KeepClusterScoped(chart)
+
+	return chart
+}
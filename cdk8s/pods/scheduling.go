@@ -0,0 +1,130 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type AntiAffinity string
+
+const (
+	// Replicas of the same service never share a node.
+	AntiAffinity_REQUIRED AntiAffinity = "REQUIRED"
+	// Replicas of the same service avoid sharing a node when possible.
+	AntiAffinity_PREFERRED AntiAffinity = "PREFERRED"
+)
+
+// SchedulingProps controls where the pods of a workload land. AntiAffinity and
+// SpreadReplicas select the replicas of the same io.service; TopologySpread
+// constraints are added as given.
+type SchedulingProps struct {
+	NodeSelector          *map[string]*string
+	Tolerations           *[]*k8s.Toleration
+	PriorityClassName     *string
+	RequiredNodeAffinity  *[]*k8s.NodeSelectorTerm
+	PreferredNodeAffinity *[]*k8s.PreferredSchedulingTerm
+	AntiAffinity          AntiAffinity
+	SpreadReplicas        *bool
+	TopologySpread        *[]*k8s.TopologySpreadConstraint
+}
+
+func (props *SchedulingProps) affinity(selector *map[string]*string) *k8s.Affinity {
+
+	var affinity *k8s.Affinity
+
+	if props.RequiredNodeAffinity != nil || props.PreferredNodeAffinity != nil {
+		affinity = &k8s.Affinity{
+			NodeAffinity: &k8s.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: props.PreferredNodeAffinity,
+			},
+		}
+		if props.RequiredNodeAffinity != nil {
+			affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8s.NodeSelector{
+				NodeSelectorTerms: props.RequiredNodeAffinity,
+			}
+		}
+	}
+
+	if props.AntiAffinity != "" {
+		if affinity == nil {
+			affinity = &k8s.Affinity{}
+		}
+		term := &k8s.PodAffinityTerm{
+			TopologyKey: jsii.String("kubernetes.io/hostname"),
+			LabelSelector: &k8s.LabelSelector{
+				MatchLabels: selector,
+			},
+		}
+		switch props.AntiAffinity {
+		case AntiAffinity_REQUIRED:
+			affinity.PodAntiAffinity = &k8s.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &[]*k8s.PodAffinityTerm{term},
+			}
+		case AntiAffinity_PREFERRED:
+			affinity.PodAntiAffinity = &k8s.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: &[]*k8s.WeightedPodAffinityTerm{
+					{
+						Weight:          jsii.Number(100),
+						PodAffinityTerm: term,
+					},
+				},
+			}
+		default:
+			panic(fmt.Sprintf("Неизвестный режим anti-affinity: %s", props.AntiAffinity))
+		}
+	}
+
+	return affinity
+}
+
+func (props *SchedulingProps) topologySpread(selector *map[string]*string) []*k8s.TopologySpreadConstraint {
+
+	constraints := []*k8s.TopologySpreadConstraint{}
+
+	if props.SpreadReplicas != nil && *props.SpreadReplicas {
+		for _, topologyKey := range []string{"topology.kubernetes.io/zone", "kubernetes.io/hostname"} {
+			constraints = append(constraints, &k8s.TopologySpreadConstraint{
+				MaxSkew:           jsii.Number(1),
+				TopologyKey:       jsii.String(topologyKey),
+				WhenUnsatisfiable: jsii.String("ScheduleAnyway"),
+				LabelSelector: &k8s.LabelSelector{
+					MatchLabels: selector,
+				},
+			})
+		}
+	}
+
+	if props.TopologySpread != nil {
+		constraints = append(constraints, *props.TopologySpread...)
+	}
+
+	return constraints
+}
+
+// Apply patches the pod spec found at path of the api object.
+// The selector identifies the replicas of the workload.
+func (props *SchedulingProps) Apply(apiObject cdk8s.ApiObject, path string, selector *map[string]*string) {
+
+	add := func(field string, value interface{}) {
+		apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(jsii.String(fmt.Sprintf("%s/%s", path, field)), value))
+	}
+
+	if props.NodeSelector != nil {
+		add("nodeSelector", props.NodeSelector)
+	}
+	if props.Tolerations != nil {
+		add("tolerations", props.Tolerations)
+	}
+	if props.PriorityClassName != nil {
+		add("priorityClassName", props.PriorityClassName)
+	}
+	if affinity := props.affinity(selector); affinity != nil {
+		add("affinity", affinity)
+	}
+	if constraints := props.topologySpread(selector); len(constraints) > 0 {
+		add("topologySpreadConstraints", &constraints)
+	}
+}
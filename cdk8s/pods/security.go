@@ -0,0 +1,174 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type SecurityLevel string
+
+const (
+	// The insecure context the constructs render without a profile.
+	SecurityLevel_PRIVILEGED SecurityLevel = "PRIVILEGED"
+	// Pod Security Standard baseline: no privileged containers, RuntimeDefault seccomp.
+	SecurityLevel_BASELINE SecurityLevel = "BASELINE"
+	// Pod Security Standard restricted: non-root, read-only root filesystem, no capabilities.
+	SecurityLevel_RESTRICTED SecurityLevel = "RESTRICTED"
+)
+
+// SecurityProfile sets the security context of the pod and all its
+// containers. Fields left nil take the value of the Level, RESTRICTED by
+// default. WritablePaths get emptyDir volumes mounted into every container,
+// so they stay writable with a read-only root filesystem.
+type SecurityProfile struct {
+	Level                    SecurityLevel
+	RunAsNonRoot             *bool
+	User                     *float64
+	Group                    *float64
+	FsGroup                  *float64
+	ReadOnlyRootFilesystem   *bool
+	AllowPrivilegeEscalation *bool
+	Privileged               *bool
+	DropCapabilities         *[]*string
+	AddCapabilities          *[]*string
+	SeccompProfile           *string
+	WritablePaths            *[]*string
+}
+
+func (props *SecurityProfile) defaultProps() {
+	if props.Level == "" {
+		props.Level = SecurityLevel_RESTRICTED
+	}
+	if props.AllowPrivilegeEscalation == nil {
+		props.AllowPrivilegeEscalation = jsii.Bool(false)
+	}
+	if props.Privileged == nil {
+		props.Privileged = jsii.Bool(false)
+	}
+
+	switch props.Level {
+	case SecurityLevel_PRIVILEGED:
+	case SecurityLevel_BASELINE:
+		if props.SeccompProfile == nil {
+			props.SeccompProfile = jsii.String("RuntimeDefault")
+		}
+	case SecurityLevel_RESTRICTED:
+		if props.RunAsNonRoot == nil {
+			props.RunAsNonRoot = jsii.Bool(true)
+		}
+		if props.User == nil {
+			props.User = jsii.Number(1000)
+		}
+		if props.Group == nil {
+			props.Group = jsii.Number(1000)
+		}
+		if props.FsGroup == nil {
+			props.FsGroup = jsii.Number(1000)
+		}
+		if props.ReadOnlyRootFilesystem == nil {
+			props.ReadOnlyRootFilesystem = jsii.Bool(true)
+		}
+		if props.DropCapabilities == nil {
+			props.DropCapabilities = &[]*string{jsii.String("ALL")}
+		}
+		if props.SeccompProfile == nil {
+			props.SeccompProfile = jsii.String("RuntimeDefault")
+		}
+		if props.WritablePaths == nil {
+			props.WritablePaths = &[]*string{jsii.String("/tmp")}
+		}
+	default:
+		panic(fmt.Sprintf("Неизвестный уровень безопасности: %s", props.Level))
+	}
+
+	if props.RunAsNonRoot == nil {
+		props.RunAsNonRoot = jsii.Bool(false)
+	}
+	if props.ReadOnlyRootFilesystem == nil {
+		props.ReadOnlyRootFilesystem = jsii.Bool(false)
+	}
+	if props.WritablePaths == nil {
+		props.WritablePaths = &[]*string{}
+	}
+}
+
+func (props *SecurityProfile) podContext() *k8s.PodSecurityContext {
+
+	context := &k8s.PodSecurityContext{
+		RunAsNonRoot: props.RunAsNonRoot,
+		RunAsUser:    props.User,
+		RunAsGroup:   props.Group,
+		FsGroup:      props.FsGroup,
+	}
+
+	if props.SeccompProfile != nil {
+		context.SeccompProfile = &k8s.SeccompProfile{Type: props.SeccompProfile}
+	}
+
+	return context
+}
+
+func (props *SecurityProfile) containerContext() *k8s.SecurityContext {
+
+	context := &k8s.SecurityContext{
+		RunAsNonRoot:             props.RunAsNonRoot,
+		RunAsUser:                props.User,
+		RunAsGroup:               props.Group,
+		ReadOnlyRootFilesystem:   props.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: props.AllowPrivilegeEscalation,
+		Privileged:               props.Privileged,
+	}
+
+	if props.DropCapabilities != nil || props.AddCapabilities != nil {
+		context.Capabilities = &k8s.Capabilities{
+			Drop: props.DropCapabilities,
+			Add:  props.AddCapabilities,
+		}
+	}
+
+	return context
+}
+
+func writableVolumeName(path string) string {
+	return fmt.Sprintf("writable-%s", strings.ReplaceAll(strings.Trim(path, "/"), "/", "-"))
+}
+
+// Apply replaces the security contexts of the pod whose spec lives at the
+// given path and of all its containers, so it must run after every container
+// has been added.
+func (props *SecurityProfile) Apply(pod cdk8splus28.AbstractPod, path string) {
+
+	props.defaultProps()
+
+	pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String(fmt.Sprintf("%s/securityContext", path)),
+		props.podContext(),
+	))
+
+	for i := range *pod.Containers() {
+		pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String(fmt.Sprintf("%s/containers/%d/securityContext", path, i)),
+			props.containerContext(),
+		))
+	}
+
+	for i := range *pod.InitContainers() {
+		pod.ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String(fmt.Sprintf("%s/initContainers/%d/securityContext", path, i)),
+			props.containerContext(),
+		))
+	}
+
+	for _, writable := range *props.WritablePaths {
+		name := writableVolumeName(*writable)
+		volume := cdk8splus28.Volume_FromEmptyDir(pod, jsii.String(name), jsii.String(name), nil)
+		for _, container := range append(*pod.Containers(), *pod.InitContainers()...) {
+			container.Mount(writable, volume, nil)
+		}
+	}
+}
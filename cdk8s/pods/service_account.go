@@ -0,0 +1,137 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	namespaces "github.com/erritis/cdk8skit/v4/cdk8s/namespaces"
+)
+
+// AccessRule grants verbs on resources of the API groups, the core group when
+// ApiGroups is nil. ResourceNames narrows the rule to the named objects.
+type AccessRule struct {
+	ApiGroups     *[]*string
+	Resources     *[]*string
+	Verbs         *[]*string
+	ResourceNames *[]*string
+}
+
+func (rule *AccessRule) policyRule() *k8s.PolicyRule {
+
+	apiGroups := rule.ApiGroups
+	if apiGroups == nil {
+		apiGroups = &[]*string{jsii.String("")}
+	}
+
+	return &k8s.PolicyRule{
+		ApiGroups:     apiGroups,
+		Resources:     rule.Resources,
+		Verbs:         rule.Verbs,
+		ResourceNames: rule.ResourceNames,
+	}
+}
+
+func policyRules(rules *[]*AccessRule) *[]*k8s.PolicyRule {
+
+	policy := []*k8s.PolicyRule{}
+
+	for _, rule := range *rules {
+		policy = append(policy, rule.policyRule())
+	}
+
+	return &policy
+}
+
+type ServiceAccountResource struct {
+	ServiceAccount     cdk8splus28.ServiceAccount
+	Role               k8s.KubeRole
+	RoleBinding        k8s.KubeRoleBinding
+	ClusterRole        k8s.KubeClusterRole
+	ClusterRoleBinding k8s.KubeClusterRoleBinding
+}
+
+// ServiceAccountProps describes a dedicated account of a workload. Rules are
+// granted in its namespace through a Role, ClusterRules cluster-wide through a
+// ClusterRole. The token is mounted by default only when some rule is set.
+type ServiceAccountProps struct {
+	AutomountToken *bool
+	Rules          *[]*AccessRule
+	ClusterRules   *[]*AccessRule
+	Namespace      *string
+}
+
+func (props *ServiceAccountProps) defaultProps(scope constructs.Construct) {
+	if props.Rules == nil {
+		props.Rules = &[]*AccessRule{}
+	}
+	if props.ClusterRules == nil {
+		props.ClusterRules = &[]*AccessRule{}
+	}
+	if props.AutomountToken == nil {
+		props.AutomountToken = jsii.Bool(len(*props.Rules) > 0 || len(*props.ClusterRules) > 0)
+	}
+	if props.Namespace == nil {
+		props.Namespace = cdk8s.Chart_Of(scope).Namespace()
+	}
+	if props.Namespace == nil {
+		props.Namespace = jsii.String("default")
+	}
+}
+
+func NewServiceAccount(scope constructs.Construct, id string, props *ServiceAccountProps) ServiceAccountResource {
+
+	props.defaultProps(scope)
+
+	serviceAccount := cdk8splus28.NewServiceAccount(
+		scope,
+		jsii.String(id),
+		&cdk8splus28.ServiceAccountProps{
+			AutomountToken: props.AutomountToken,
+		},
+	)
+
+	subjects := &[]*k8s.Subject{
+		{
+			Kind:      jsii.String("ServiceAccount"),
+			Name:      serviceAccount.Name(),
+			Namespace: props.Namespace,
+		},
+	}
+
+	resource := ServiceAccountResource{
+		ServiceAccount: serviceAccount,
+	}
+
+	if len(*props.Rules) > 0 {
+		resource.Role = k8s.NewKubeRole(scope, jsii.String("role"), &k8s.KubeRoleProps{
+			Rules: policyRules(props.Rules),
+		})
+		resource.RoleBinding = k8s.NewKubeRoleBinding(scope, jsii.String("role-binding"), &k8s.KubeRoleBindingProps{
+			RoleRef: &k8s.RoleRef{
+				ApiGroup: jsii.String("rbac.authorization.k8s.io"),
+				Kind:     jsii.String("Role"),
+				Name:     resource.Role.Name(),
+			},
+			Subjects: subjects,
+		})
+	}
+
+	if len(*props.ClusterRules) > 0 {
+		resource.ClusterRole = k8s.NewKubeClusterRole(scope, jsii.String("cluster-role"), &k8s.KubeClusterRoleProps{
+			Rules: policyRules(props.ClusterRules),
+		})
+		resource.ClusterRoleBinding = k8s.NewKubeClusterRoleBinding(scope, jsii.String("cluster-role-binding"), &k8s.KubeClusterRoleBindingProps{
+			RoleRef: &k8s.RoleRef{
+				ApiGroup: jsii.String("rbac.authorization.k8s.io"),
+				Kind:     jsii.String("ClusterRole"),
+				Name:     resource.ClusterRole.Name(),
+			},
+			Subjects: subjects,
+		})
+		namespaces.KeepClusterScoped(scope)
+	}
+
+	return resource
+}
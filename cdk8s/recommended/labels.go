@@ -0,0 +1,174 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+)
+
+const (
+	nameLabel      = "app.kubernetes.io/name"
+	instanceLabel  = "app.kubernetes.io/instance"
+	versionLabel   = "app.kubernetes.io/version"
+	componentLabel = "app.kubernetes.io/component"
+	partOfLabel    = "app.kubernetes.io/part-of"
+	managedByLabel = "app.kubernetes.io/managed-by"
+
+	contextPrefix    = "cdk8skit:"
+	defaultManagedBy = "cdk8skit"
+)
+
+// Labels are the Kubernetes recommended labels. Name and Instance default to
+// the id of the construct, Version to the tag of its image.
+type Labels struct {
+	Name      *string
+	Instance  *string
+	Version   *string
+	Component *string
+	PartOf    *string
+	ManagedBy *string
+}
+
+func (labels *Labels) values() map[string]*string {
+	return map[string]*string{
+		nameLabel:      labels.Name,
+		instanceLabel:  labels.Instance,
+		versionLabel:   labels.Version,
+		componentLabel: labels.Component,
+		partOfLabel:    labels.PartOf,
+		managedByLabel: labels.ManagedBy,
+	}
+}
+
+type appLabels struct {
+	scope constructs.Construct
+}
+
+// Validate is called right before rendering and gives every object of the
+// scope the labels configured for it that the object does not set itself.
+func (app *appLabels) Validate() *[]*string {
+
+	labels := configured(app.scope)
+
+	for _, construct := range *app.scope.Node().FindAll(constructs.ConstructOrder_PREORDER) {
+		if !*cdk8s.ApiObject_IsApiObject(construct) {
+			continue
+		}
+		metadata := cdk8s.ApiObject_Of(construct).Metadata()
+		for key, value := range labels {
+			if metadata.GetLabel(jsii.String(key)) == nil {
+				metadata.AddLabel(jsii.String(key), value)
+			}
+		}
+	}
+
+	return &[]*string{}
+}
+
+// Configure sets the labels shared by all objects of the scope, typically the
+// app, such as PartOf. It must be called before anything is added to the
+// scope. Kit constructs override these values with their own.
+func Configure(scope constructs.Construct, labels *Labels) {
+
+	if labels.ManagedBy == nil {
+		labels.ManagedBy = jsii.String(defaultManagedBy)
+	}
+
+	for key, value := range labels.values() {
+		if value != nil {
+			scope.Node().SetContext(jsii.String(contextPrefix+key), value)
+		}
+	}
+
+	scope.Node().AddValidation(&appLabels{
+		scope: scope,
+	})
+}
+
+func configured(scope constructs.Construct) map[string]*string {
+
+	labels := map[string]*string{}
+
+	for key := range (&Labels{}).values() {
+		if value, ok := scope.Node().TryGetContext(jsii.String(contextPrefix + key)).(string); ok {
+			labels[key] = jsii.String(value)
+		}
+	}
+
+	return labels
+}
+
+// Tracker gives the recommended labels to the objects created by a kit
+// construct between Track and Apply.
+type Tracker struct {
+	scope  constructs.Construct
+	start  int
+	labels map[string]*string
+}
+
+// Track resolves the labels of the construct with the given id: overrides
+// first, then the values configured for the scope, then the defaults. The
+// image, if any, provides the default version.
+func Track(scope constructs.Construct, id string, image *string, overrides *Labels) *Tracker {
+
+	labels := map[string]*string{
+		nameLabel:      jsii.String(id),
+		instanceLabel:  jsii.String(id),
+		managedByLabel: jsii.String(defaultManagedBy),
+	}
+
+	if image != nil {
+		// Значение метки ограничено 63 символами, длинные теги не подходят
+		if tag := containers.Image_Parse(*image).Tag; tag != nil && *tag != "latest" && len(*tag) <= 63 {
+			labels[versionLabel] = tag
+		}
+	}
+
+	for key, value := range configured(scope) {
+		labels[key] = value
+	}
+
+	if overrides != nil {
+		for key, value := range overrides.values() {
+			if value != nil {
+				labels[key] = value
+			}
+		}
+	}
+
+	return &Tracker{
+		scope:  scope,
+		start:  len(*scope.Node().Children()),
+		labels: labels,
+	}
+}
+
+// Merge adds the labels to the given pod template labels, keeping the keys
+// already set. Selectors keep using io.service, so a new version never
+// changes them.
+func (tracker *Tracker) Merge(labels map[string]*string) {
+	for key, value := range tracker.labels {
+		if _, ok := labels[key]; !ok {
+			labels[key] = value
+		}
+	}
+}
+
+// Apply labels every object created in the scope since Track.
+func (tracker *Tracker) Apply() {
+
+	children := *tracker.scope.Node().Children()
+
+	for _, child := range children[tracker.start:] {
+		for _, construct := range *child.Node().FindAll(constructs.ConstructOrder_PREORDER) {
+			if !*cdk8s.ApiObject_IsApiObject(construct) {
+				continue
+			}
+			metadata := cdk8s.ApiObject_Of(construct).Metadata()
+			for key, value := range tracker.labels {
+				metadata.AddLabel(jsii.String(key), value)
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestRecommendedLabels(t *testing.T) {
+
+	app := cdk8s.NewApp(nil)
+
+	Configure(app, &Labels{
+		PartOf: jsii.String("shop"),
+	})
+
+	chart := cdk8s.NewChart(app, jsii.String("chart"), nil)
+
+	cdk8splus28.NewConfigMap(chart, jsii.String("shared"), &cdk8splus28.ConfigMapProps{})
+
+	tracker := Track(chart, "api", jsii.String("registry.example.com/api:1.25"), &Labels{
+		Component: jsii.String("backend"),
+	})
+
+	podLabels := map[string]*string{
+		"io.service": jsii.String("api"),
+	}
+
+	tracker.Merge(podLabels)
+
+	cdk8splus28.NewDeployment(chart, jsii.String("deployment"), &cdk8splus28.DeploymentProps{
+		Containers: &[]*cdk8splus28.ContainerProps{
+			{Image: jsii.String("registry.example.com/api:1.25")},
+		},
+		PodMetadata: &cdk8s.ApiObjectMetadata{
+			Labels: &podLabels,
+		},
+	})
+
+	tracker.Apply()
+
+	manifests := synth.Manifests(app)
+
+	deployment := synth.Find(t, manifests, "Deployment")
+
+	expected := map[string]string{
+		"app.kubernetes.io/name":       "api",
+		"app.kubernetes.io/instance":   "api",
+		"app.kubernetes.io/version":    "1.25",
+		"app.kubernetes.io/component":  "backend",
+		"app.kubernetes.io/part-of":    "shop",
+		"app.kubernetes.io/managed-by": "cdk8skit",
+	}
+
+	for key, value := range expected {
+		if actual := synth.Field(deployment, "metadata", "labels", key); actual != value {
+			t.Errorf("deployment %s: expected %s, got %v", key, value, actual)
+		}
+		if actual := synth.Field(deployment, "spec", "template", "metadata", "labels", key); actual != value {
+			t.Errorf("pod %s: expected %s, got %v", key, value, actual)
+		}
+	}
+
+	// Селектор не зависит от версии, иначе новая версия пересоздавала бы Deployment
+	for key := range expected {
+		if selector := synth.Field(deployment, "spec", "selector", "matchLabels", key); selector != nil {
+			t.Errorf("expected %s out of the selector, got %v", key, selector)
+		}
+	}
+
+	shared := synth.Find(t, manifests, "ConfigMap")
+
+	if partOf := synth.Field(shared, "metadata", "labels", "app.kubernetes.io/part-of"); partOf != "shop" {
+		t.Errorf("expected the app labels on every object, got %v", partOf)
+	}
+	if name := synth.Field(shared, "metadata", "labels", "app.kubernetes.io/name"); name != nil {
+		t.Errorf("expected objects created before Track to keep their name, got %v", name)
+	}
+}
+
+func TestRecommendedLabelsVersion(t *testing.T) {
+
+	images := map[string]interface{}{
+		"nginx:1.25":   "1.25",
+		"nginx":        nil,
+		"nginx:latest": nil,
+	}
+
+	for image, version := range images {
+		tracker := Track(cdk8s.NewApp(nil), "web", jsii.String(image), nil)
+
+		labels := map[string]*string{}
+		tracker.Merge(labels)
+
+		var actual interface{}
+		if value, ok := labels["app.kubernetes.io/version"]; ok {
+			actual = *value
+		}
+
+		if actual != version {
+			t.Errorf("%s: expected version %v, got %v", image, version, actual)
+		}
+	}
+}
@@ -7,12 +7,19 @@ import (
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/cdk8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
 	volumes "github.com/erritis/cdk8skit/v4/cdk8s/volumes"
 )
 
 type PostgresPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      cdk8splus28.Protocol
+	AppProtocol   *string
 }
 
 type PostgresVolumeSettings struct {
@@ -30,18 +37,37 @@ type PostgresDatabase struct {
 	Password *string
 }
 
+// PostgresProps defaults Image to postgres:16. Another image is given as a
+// name or as a structured containers.Image rendered with Name.
 type PostgresProps struct {
-	Image          *string
-	Database       *PostgresDatabase
-	VolumeSettings *PostgresVolumeSettings
-	Ports          *PostgresPort
-	Network        *string
-	Liveness       cdk8splus28.Probe
+	Image                    *string
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Database                 *PostgresDatabase
+	VolumeSettings           *PostgresVolumeSettings
+	Ports                    *PostgresPort
+	AdditionalPorts          *[]*PostgresPort
+	Network                  *string
+	Labels                   *recommended.Labels
+	Service                  *containers.ServiceOptions
+	Liveness                 cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	Resources                *cdk8splus28.ContainerResources
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+	Metrics                  *monitoring.MetricsProps
 }
 
 func (props *PostgresProps) defaultProps(scope constructs.Construct) {
 	if props.Image == nil {
-		props.Image = jsii.String("postgres:latest")
+		// Мажорная версия закреплена: с latest обновление образа ломало бы каталог данных
+		props.Image = jsii.String("postgres:16")
 	}
 	if props.VolumeSettings.PrefixSecretName == nil {
 		props.VolumeSettings.PrefixSecretName = jsii.String("postgres")
@@ -121,14 +147,41 @@ func (props *PostgresProps) defaultLivenessProps() {
 	}
 }
 
+// NewPostgres returns the StatefulSet alone. NewPostgresResource returns every
+// object it creates.
 func NewPostgres(
 	scope constructs.Construct,
 	id string,
 	props *PostgresProps,
 ) cdk8splus28.StatefulSet {
+	return NewPostgresResource(scope, id, props).StatefulSet
+}
+
+func NewPostgresResource(
+	scope constructs.Construct,
+	id string,
+	props *PostgresProps,
+) StatefulSetResource {
+
+	// Трекер создаётся до значений по умолчанию, которые уже добавляют том
+	tracker := recommended.Track(scope, id, props.Image, props.Labels)
 
 	props.defaultProps(scope)
 
+	additionalPorts := []*StatefulSetPort{}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			additionalPorts = append(additionalPorts, &StatefulSetPort{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
+
 	db := volumes.NewSecretVolume(
 		scope, "name-secret",
 		props.VolumeSettings.PrefixSecretName,
@@ -150,16 +203,26 @@ func NewPostgres(
 		&volumes.SecretVolumeProps{},
 	)
 
-	postgres := NewStatefulSet(
+	postgres := NewStatefulSetResource(
 		scope,
 		id,
 		*props.Image,
 		&StatefulSetProps{
 			Ports: &StatefulSetPort{
+				Name:          props.Ports.Name,
 				Port:          props.Ports.Port,
 				ContainerPort: props.Ports.ContainerPort,
+				Protocol:      props.Ports.Protocol,
+				AppProtocol:   props.Ports.AppProtocol,
 			},
-			Network: props.Network,
+			AdditionalPorts: &additionalPorts,
+			ImagePullPolicy: props.ImagePullPolicy,
+			Command:         props.Command,
+			Args:            props.Args,
+			WorkingDir:      props.WorkingDir,
+			Network:         props.Network,
+			Labels:          props.Labels,
+			Service:         props.Service,
 			Variables: &map[*string]*string{
 				jsii.String("POSTGRES_DB_FILE"):       jsii.String(fmt.Sprintf("/run/secrets/%[1]s/%[1]s", *props.VolumeSettings.PrefixSecretName)),
 				jsii.String("POSTGRES_USER_FILE"):     jsii.String(fmt.Sprintf("/run/secrets/%[1]s-user/%[1]s-user", *props.VolumeSettings.PrefixSecretName)),
@@ -174,9 +237,20 @@ func NewPostgres(
 				jsii.String(fmt.Sprintf("/run/secrets/%s-user", *props.VolumeSettings.PrefixSecretName)):   &dbUser,
 				jsii.String(fmt.Sprintf("/run/secrets/%s-passwd", *props.VolumeSettings.PrefixSecretName)): &dbPasswd,
 			},
-			Liveness: props.Liveness,
+			Liveness:                 props.Liveness,
+			PostStart:                props.PostStart,
+			PreStop:                  props.PreStop,
+			TerminationGracePeriod:   props.TerminationGracePeriod,
+			TerminationMessagePolicy: props.TerminationMessagePolicy,
+			Resources:                props.Resources,
+			Scheduling:               props.Scheduling,
+			SecurityProfile:          props.SecurityProfile,
+			ServiceAccount:           props.ServiceAccount,
+			Metrics:                  props.Metrics,
 		},
 	)
 
+	tracker.Apply()
+
 	return postgres
 }
@@ -0,0 +1,39 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestPostgresDefaultImage(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewPostgres(chart, "db", &PostgresProps{
+		VolumeSettings: &PostgresVolumeSettings{},
+	})
+
+	statefulSet := synth.Find(t, synth.Manifests(app), "StatefulSet")
+
+	if image := synth.Field(statefulSet, "spec", "template", "spec", "containers", 0, "image"); image != "postgres:16" {
+		t.Errorf("expected the pinned postgres:16 image, got %v", image)
+	}
+}
+
+func TestPostgresImage(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewPostgres(chart, "db", &PostgresProps{
+		Image:          jsii.String("postgres:15.6"),
+		VolumeSettings: &PostgresVolumeSettings{},
+	})
+
+	statefulSet := synth.Find(t, synth.Manifests(app), "StatefulSet")
+
+	if image := synth.Field(statefulSet, "spec", "template", "spec", "containers", 0, "image"); image != "postgres:15.6" {
+		t.Errorf("expected the given image, got %v", image)
+	}
+}
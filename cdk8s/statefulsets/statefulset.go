@@ -1,26 +1,66 @@
 package cdk8skit
 
 import (
-	"fmt"
-
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/cdk8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/cdk8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/cdk8s/recommended"
 )
 
+// StatefulSetResource holds the headless governing Service giving each pod a
+// stable DNS name, the ClientService created when Service options are set and
+// the Monitor created when Metrics are set. The governing Service keeps its
+// name but used to have a cluster IP, which cannot be removed in place: delete
+// the old Service before applying.
+type StatefulSetResource struct {
+	StatefulSet   cdk8splus28.StatefulSet
+	Service       cdk8splus28.Service
+	ClientService cdk8splus28.Service
+	Monitor       cdk8s.ApiObject
+}
+
 type StatefulSetPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      cdk8splus28.Protocol
+	AppProtocol   *string
 }
 
 type StatefulSetProps struct {
-	Ports     *StatefulSetPort
-	Network   *string
-	Variables *map[*string]*string
-	Claims    *[]*cdk8splus28.PersistentVolumeClaim
-	Volumes   *map[*string]*cdk8splus28.Volume
-	Liveness  cdk8splus28.Probe
+	ImagePullPolicy          cdk8splus28.ImagePullPolicy
+	Command                  *[]*string
+	Args                     *[]*string
+	WorkingDir               *string
+	Ports                    *StatefulSetPort
+	AdditionalPorts          *[]*StatefulSetPort
+	Network                  *string
+	Labels                   *recommended.Labels
+	Service                  *containers.ServiceOptions
+	Variables                *map[*string]*string
+	Environment              *map[*string]*containers.EnvSource
+	EnvFrom                  *[]*containers.EnvFromSource
+	Claims                   *[]*cdk8splus28.PersistentVolumeClaim
+	Volumes                  *map[*string]*cdk8splus28.Volume
+	Mounts                   *map[*string]*containers.Mount
+	Liveness                 cdk8splus28.Probe
+	Readiness                cdk8splus28.Probe
+	Startup                  cdk8splus28.Probe
+	PostStart                cdk8splus28.Handler
+	PreStop                  cdk8splus28.Handler
+	TerminationGracePeriod   cdk8s.Duration
+	TerminationMessagePolicy *string
+	Resources                *cdk8splus28.ContainerResources
+	InitContainers           *[]*containers.ContainerProps
+	Sidecars                 *[]*containers.ContainerProps
+	Scheduling               *pods.SchedulingProps
+	SecurityProfile          *pods.SecurityProfile
+	ServiceAccount           *pods.ServiceAccountProps
+	Metrics                  *monitoring.MetricsProps
 }
 
 func (props *StatefulSetProps) defaultProps() {
@@ -34,65 +74,128 @@ func (props *StatefulSetProps) defaultProps() {
 	if props.Ports.ContainerPort == nil {
 		props.Ports.ContainerPort = jsii.Number(8080)
 	}
+	if props.Resources == nil {
+		props.Resources = &cdk8splus28.ContainerResources{}
+	}
+}
+
+func (props *StatefulSetProps) ports() []*containers.Port {
+
+	ports := []*containers.Port{
+		{
+			Name:          props.Ports.Name,
+			Port:          props.Ports.Port,
+			ContainerPort: props.Ports.ContainerPort,
+			Protocol:      props.Ports.Protocol,
+			AppProtocol:   props.Ports.AppProtocol,
+		},
+	}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			ports = append(ports, &containers.Port{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
+
+	return ports
 }
 
+// NewStatefulSet returns the StatefulSet alone, its governing Service is
+// reachable through Service. NewStatefulSetResource returns every object it
+// creates.
 func NewStatefulSet(
 	scope constructs.Construct,
 	id string,
 	image string,
 	props *StatefulSetProps,
 ) cdk8splus28.StatefulSet {
+	return NewStatefulSetResource(scope, id, image, props).StatefulSet
+}
+
+func NewStatefulSetResource(
+	scope constructs.Construct,
+	id string,
+	image string,
+	props *StatefulSetProps,
+) StatefulSetResource {
 
 	props.defaultProps()
 
+	tracker := recommended.Track(scope, id, jsii.String(image), props.Labels)
+
+	ports := props.ports()
+
 	container := cdk8splus28.NewContainer(&cdk8splus28.ContainerProps{
-		Name:       jsii.String(id),
-		Image:      jsii.String(image),
-		PortNumber: props.Ports.ContainerPort,
-		Resources: &cdk8splus28.ContainerResources{
-			Cpu:              nil,
-			EphemeralStorage: nil,
-			Memory:           nil,
-		},
+		Name:            jsii.String(id),
+		Image:           jsii.String(image),
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		WorkingDir:      props.WorkingDir,
+		PortNumber:      props.Ports.ContainerPort,
+		Ports:           containers.AdditionalContainerPorts(ports),
+		Resources:       props.Resources,
 		SecurityContext: &cdk8splus28.ContainerSecurityContextProps{
 			ReadOnlyRootFilesystem: jsii.Bool(false),
 			EnsureNonRoot:          jsii.Bool(false),
 		},
-		Liveness: props.Liveness,
+		Liveness:  containers.NativeProbe(props.Liveness),
+		Readiness: containers.NativeProbe(props.Readiness),
+		Startup:   containers.NativeProbe(props.Startup),
+		Lifecycle: containers.Lifecycle(props.PostStart, props.PreStop),
 	})
 
-	for k, v := range *props.Variables {
-		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue(v))
+	for _, k := range containers.SortedKeys(*props.Variables) {
+		container.Env().AddVariable(k, cdk8splus28.EnvValue_FromValue((*props.Variables)[k]))
 	}
 
-	for path, volume := range *props.Volumes {
-		var storage cdk8splus28.IStorage = *volume
+	for _, path := range containers.SortedKeys(*props.Volumes) {
+		var storage cdk8splus28.IStorage = *(*props.Volumes)[path]
 		container.Mount(path, storage, nil)
 	}
 
+	containers.MountVolumes(container, props.Mounts)
+
 	labels := make(map[string]*string)
 
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
 	if props.Network != nil {
 		labels[*props.Network] = jsii.String("true")
 	}
 
+	var serviceAccount cdk8splus28.IServiceAccount
+	var automountToken *bool
+
+	if props.ServiceAccount != nil {
+		serviceAccount = pods.NewServiceAccount(scope, "service-account", props.ServiceAccount).ServiceAccount
+		automountToken = props.ServiceAccount.AutomountToken
+	}
+
 	statefulset := cdk8splus28.NewStatefulSet(
 		scope,
 		jsii.String("statefulset"),
 		&cdk8splus28.StatefulSetProps{
-			Replicas: jsii.Number(1),
+			Replicas:                     jsii.Number(1),
+			TerminationGracePeriod:       props.TerminationGracePeriod,
+			ServiceAccount:               serviceAccount,
+			AutomountServiceAccountToken: automountToken,
+			// Имя сервиса не меняется: serviceName стейтфулсета тоже неизменяем
 			Service: cdk8splus28.NewService(
 				scope,
 				jsii.String("service"),
 				&cdk8splus28.ServiceProps{
-					Type: cdk8splus28.ServiceType_CLUSTER_IP,
-					Ports: &[]*cdk8splus28.ServicePort{
-						{
-							Name:       jsii.String(fmt.Sprintf("%d", int(*props.Ports.Port))),
-							Port:       props.Ports.Port,
-							TargetPort: props.Ports.ContainerPort,
-						},
-					},
+					Type:      cdk8splus28.ServiceType_CLUSTER_IP,
+					ClusterIP: jsii.String("None"),
+					Ports:     containers.ServicePorts(ports),
 				},
 			),
 			SecurityContext: &cdk8splus28.PodSecurityContextProps{
@@ -106,6 +209,40 @@ func NewStatefulSet(
 
 	statefulset.AttachContainer(container)
 
+	containers.PatchPrimaryContainerPort(statefulset.ApiObject(), "/spec/template/spec/containers/0/ports/0", ports)
+	containers.PatchAppProtocols(statefulset.Service(), ports)
+
+	if props.Service != nil && props.Service.PublishNotReadyAddresses != nil {
+		statefulset.Service().ApiObject().AddJsonPatch(cdk8s.JsonPatch_Add(
+			jsii.String("/spec/publishNotReadyAddresses"),
+			props.Service.PublishNotReadyAddresses,
+		))
+	}
+
+	containers.PatchTerminationMessagePolicy(statefulset.ApiObject(), "/spec/template/spec", props.TerminationMessagePolicy)
+
+	containers.ConfigureEnv(statefulset, container, props.Environment, props.EnvFrom)
+
+	containers.AttachContainers(statefulset, "/spec/template/spec", props.InitContainers, props.Sidecars)
+
+	containers.AttachPullSecrets(statefulset, "/spec/template/spec")
+
+	containers.AttachChecksum(statefulset, "/spec/template/spec")
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(statefulset.ApiObject(), "/spec/template/spec", &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(statefulset, "/spec/template/spec")
+	}
+
+	containers.PatchGrpcProbe(statefulset.ApiObject(), "/spec/template/spec/containers/0/livenessProbe", props.Liveness, props.Ports.ContainerPort)
+	containers.PatchGrpcProbe(statefulset.ApiObject(), "/spec/template/spec/containers/0/readinessProbe", props.Readiness, props.Ports.ContainerPort)
+	containers.PatchGrpcProbe(statefulset.ApiObject(), "/spec/template/spec/containers/0/startupProbe", props.Startup, props.Ports.ContainerPort)
+
 	if props.Claims != nil {
 		claims := *props.Claims
 
@@ -131,5 +268,38 @@ func NewStatefulSet(
 	statefulset.Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
 	statefulset.Service().Metadata().AddLabel(jsii.String("io.service"), jsii.String(id))
 
-	return statefulset
+	var clientService cdk8splus28.Service
+
+	if props.Service != nil {
+		// Без метки io.service: ServiceMonitor выбирает сервисы по ней и
+		// собирал бы метрики каждого пода дважды
+		clientService = cdk8splus28.NewService(
+			scope,
+			jsii.String("client-service"),
+			&cdk8splus28.ServiceProps{
+				Type:     props.Service.NativeType(),
+				Ports:    containers.ServicePorts(ports),
+				Selector: statefulset,
+			},
+		)
+		containers.PatchAppProtocols(clientService, ports)
+		props.Service.Apply(clientService)
+	}
+
+	var monitor cdk8s.ApiObject
+
+	if props.Metrics != nil {
+		monitor = monitoring.NewMonitor(scope, &map[string]*string{
+			"io.service": labels["io.service"],
+		}, ports[0], props.Metrics)
+	}
+
+	tracker.Apply()
+
+	return StatefulSetResource{
+		StatefulSet:   statefulset,
+		Service:       statefulset.Service(),
+		ClientService: clientService,
+		Monitor:       monitor,
+	}
 }
@@ -0,0 +1,124 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/cdk8s/monitoring"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func newTestStatefulSetProps() *StatefulSetProps {
+	return &StatefulSetProps{
+		Variables: &map[*string]*string{},
+		Volumes:   &map[*string]*cdk8splus28.Volume{},
+	}
+}
+
+func TestStatefulSetServiceMonitor(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	props := newTestStatefulSetProps()
+	props.Metrics = &monitoring.MetricsProps{}
+
+	resource := NewStatefulSetResource(chart, "store", "store:1.0", props)
+
+	if resource.Monitor == nil {
+		t.Fatal("expected a monitor")
+	}
+
+	monitor := synth.Find(t, synth.Manifests(app), "ServiceMonitor")
+
+	if service := synth.Field(monitor, "spec", "selector", "matchLabels", "io.service"); service != "store" {
+		t.Errorf("expected the monitor to select the store Service, got %v", service)
+	}
+	if port := synth.Field(monitor, "spec", "endpoints", 0, "port"); port != "80" {
+		t.Errorf("expected the primary Service port, got %v", port)
+	}
+}
+
+func TestStatefulSetPodMonitor(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	props := newTestStatefulSetProps()
+	props.Metrics = &monitoring.MetricsProps{PodMonitor: jsii.Bool(true)}
+
+	NewStatefulSet(chart, "store", "store:1.0", props)
+
+	manifests := synth.Manifests(app)
+
+	if monitors := synth.All(manifests, "ServiceMonitor"); len(monitors) != 0 {
+		t.Errorf("expected no ServiceMonitor, got %d", len(monitors))
+	}
+
+	monitor := synth.Find(t, manifests, "PodMonitor")
+
+	if service := synth.Field(monitor, "spec", "selector", "matchLabels", "io.service"); service != "store" {
+		t.Errorf("expected the monitor to select the store pods, got %v", service)
+	}
+	if port := synth.Field(monitor, "spec", "podMetricsEndpoints", 0, "targetPort"); port != float64(8080) {
+		t.Errorf("expected the container port, got %v", port)
+	}
+}
+
+func TestStatefulSetWithoutMetrics(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	resource := NewStatefulSetResource(chart, "store", "store:1.0", newTestStatefulSetProps())
+
+	if resource.Monitor != nil {
+		t.Error("expected no monitor without metrics")
+	}
+	if monitors := synth.All(synth.Manifests(app), "ServiceMonitor"); len(monitors) != 0 {
+		t.Errorf("expected no ServiceMonitor, got %d", len(monitors))
+	}
+}
+
+func TestStatefulSetServices(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	props := newTestStatefulSetProps()
+	props.Service = &containers.ServiceOptions{
+		Type:     containers.ServiceType_NODE_PORT,
+		NodePort: jsii.Number(30080),
+	}
+
+	resource := NewStatefulSetResource(chart, "store", "store:1.0", props)
+
+	manifests := synth.Manifests(app)
+
+	statefulSet := synth.Find(t, manifests, "StatefulSet")
+
+	if name := synth.Field(statefulSet, "spec", "serviceName"); name != *resource.Service.Name() {
+		t.Errorf("expected the governing Service %s, got %v", *resource.Service.Name(), name)
+	}
+
+	services := map[string]map[string]interface{}{}
+	for _, service := range synth.All(manifests, "Service") {
+		services[synth.Field(service, "metadata", "name").(string)] = service
+	}
+
+	governing := services[*resource.Service.Name()]
+
+	if address := synth.Field(governing, "spec", "clusterIP"); address != "None" {
+		t.Errorf("expected a headless governing Service, got %v", address)
+	}
+
+	client := services[*resource.ClientService.Name()]
+
+	if kind := synth.Field(client, "spec", "type"); kind != "NodePort" {
+		t.Errorf("expected a NodePort client Service, got %v", kind)
+	}
+	if port := synth.Field(client, "spec", "ports", 0, "nodePort"); port != float64(30080) {
+		t.Errorf("expected node port 30080, got %v", port)
+	}
+	if label := synth.Field(client, "metadata", "labels", "io.service"); label != nil {
+		t.Errorf("expected the client Service to stay out of the ServiceMonitor, got %v", label)
+	}
+}
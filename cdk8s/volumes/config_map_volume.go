@@ -0,0 +1,171 @@
+package cdk8skit
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
+)
+
+type ConfigMapVolumeResource struct {
+	Volume    cdk8splus28.Volume
+	ConfigMap cdk8splus28.ConfigMap
+	items     map[string]*string
+}
+
+type ConfigMapItem struct {
+	Path *string
+	Mode *float64
+}
+
+// ConfigMapVolumeProps holds the ConfigMap keys. BinaryData values are
+// base64 encoded. Items limits the volume to the listed keys. Checksum, true
+// by default, rolls out the pods using the ConfigMap when its content changes.
+type ConfigMapVolumeProps struct {
+	Name        *string
+	Data        *map[string]*string
+	BinaryData  *map[string]*string
+	Items       *map[string]*ConfigMapItem
+	DefaultMode *float64
+	Immutable   *bool
+	Checksum    *bool
+}
+
+func (props *ConfigMapVolumeProps) defaultProps(id string) {
+	if props.Name == nil {
+		props.Name = jsii.String(id)
+	}
+	if props.Data == nil {
+		props.Data = &map[string]*string{}
+	}
+	if props.BinaryData == nil {
+		props.BinaryData = &map[string]*string{}
+	}
+	if props.Immutable == nil {
+		props.Immutable = jsii.Bool(false)
+	}
+	if props.Checksum == nil {
+		props.Checksum = jsii.Bool(true)
+	}
+}
+
+func NewConfigMapVolume(scope constructs.Construct, id string, props *ConfigMapVolumeProps) ConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	configMap := cdk8splus28.NewConfigMap(
+		scope,
+		jsii.String(id),
+		&cdk8splus28.ConfigMapProps{
+			Data:       props.Data,
+			BinaryData: props.BinaryData,
+			Immutable:  props.Immutable,
+		},
+	)
+
+	if !*props.Checksum {
+		containers.SkipChecksum(configMap.ApiObject())
+	}
+
+	var items *map[string]*cdk8splus28.PathMapping
+
+	paths := map[string]*string{}
+
+	if props.Items != nil {
+		items = &map[string]*cdk8splus28.PathMapping{}
+		for key, item := range *props.Items {
+			path := item.Path
+			if path == nil {
+				path = jsii.String(key)
+			}
+			(*items)[key] = &cdk8splus28.PathMapping{
+				Path: path,
+				Mode: item.Mode,
+			}
+			paths[key] = path
+		}
+	}
+
+	volume := cdk8splus28.Volume_FromConfigMap(
+		scope,
+		jsii.String(fmt.Sprintf("%s-volume", id)),
+		configMap,
+		&cdk8splus28.ConfigMapVolumeOptions{
+			Name:        props.Name,
+			Items:       items,
+			DefaultMode: props.DefaultMode,
+		},
+	)
+
+	return ConfigMapVolumeResource{
+		Volume:    volume,
+		ConfigMap: configMap,
+		items:     paths,
+	}
+}
+
+// File mounts the single key of the ConfigMap through a sub path, so the
+// rest of the target directory stays intact.
+func (resource ConfigMapVolumeResource) File(key string) *containers.Mount {
+
+	path, ok := resource.items[key]
+	if !ok {
+		path = jsii.String(key)
+	}
+
+	return &containers.Mount{
+		Volume:   &resource.Volume,
+		SubPath:  path,
+		ReadOnly: jsii.Bool(true),
+	}
+}
+
+func addFileData(props *ConfigMapVolumeProps, key string, filename string) {
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при чтении файла: %s", err))
+	}
+
+	if utf8.Valid(content) {
+		(*props.Data)[key] = jsii.String(string(content))
+	} else {
+		(*props.BinaryData)[key] = jsii.String(base64.StdEncoding.EncodeToString(content))
+	}
+}
+
+func ConfigMapVolume_FromFile(scope constructs.Construct, id string, path *string, props *ConfigMapVolumeProps) ConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	addFileData(props, filepath.Base(*path), *path)
+
+	return NewConfigMapVolume(scope, id, props)
+}
+
+// ConfigMapVolume_FromDirectory stores every regular file of the directory
+// under its file name. Subdirectories are skipped.
+func ConfigMapVolume_FromDirectory(scope constructs.Construct, id string, dir *string, props *ConfigMapVolumeProps) ConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	entries, err := os.ReadDir(*dir)
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при чтении каталога: %s", err))
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		addFileData(props, entry.Name(), filepath.Join(*dir, entry.Name()))
+	}
+
+	return NewConfigMapVolume(scope, id, props)
+}
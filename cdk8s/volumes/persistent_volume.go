@@ -7,6 +7,7 @@ import (
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	namespaces "github.com/erritis/cdk8skit/v4/cdk8s/namespaces"
 )
 
 type PersistentVolumeResource struct {
@@ -67,6 +68,8 @@ func NewPersistentVolume(scope constructs.Construct, id string, props *Persisten
 		},
 	)
 
+	namespaces.KeepClusterScoped(scope)
+
 	return PersistentVolumeResource{
 		PersistentVolume: persistentVolume,
 		Volume:           volume,
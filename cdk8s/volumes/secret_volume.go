@@ -8,16 +8,23 @@ import (
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2"
+	containers "github.com/erritis/cdk8skit/v4/cdk8s/containers"
 )
 
+// SecretVolumeProps describes the secret. Checksum, true by default, rolls
+// out the pods mounting the volume when the value changes.
 type SecretVolumeProps struct {
-	Encrypt *bool
+	Encrypt  *bool
+	Checksum *bool
 }
 
 func (props *SecretVolumeProps) defaultProps() {
 	if props.Encrypt == nil {
 		props.Encrypt = jsii.Bool(true)
 	}
+	if props.Checksum == nil {
+		props.Checksum = jsii.Bool(true)
+	}
 }
 
 func NewSecretVolume(scope constructs.Construct, id string, name *string, value *string, props *SecretVolumeProps) cdk8splus28.Volume {
@@ -32,6 +39,10 @@ func NewSecretVolume(scope constructs.Construct, id string, name *string, value
 
 	secret.AddStringData(name, value)
 
+	if !*props.Checksum {
+		containers.SkipChecksum(secret.ApiObject())
+	}
+
 	if !*props.Encrypt {
 		secret.ApiObject().AddJsonPatch(
 			cdk8s.JsonPatch_Move(
@@ -0,0 +1,112 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+)
+
+// NewChart returns an app with a single chart in the given namespace, or in
+// none when namespace is empty.
+func NewChart(namespace string) (cdk8s.App, cdk8s.Chart) {
+
+	app := cdk8s.NewApp(nil)
+
+	props := &cdk8s.ChartProps{}
+	if namespace != "" {
+		props.Namespace = jsii.String(namespace)
+	}
+
+	return app, cdk8s.NewChart(app, jsii.String("chart"), props)
+}
+
+// Manifests renders the app, running its validations, and returns the
+// manifests of all its objects in construct order.
+func Manifests(app cdk8s.App) []map[string]interface{} {
+
+	app.SynthYaml()
+
+	manifests := []map[string]interface{}{}
+
+	for _, construct := range *app.Node().FindAll(constructs.ConstructOrder_PREORDER) {
+		if !*cdk8s.ApiObject_IsApiObject(construct) {
+			continue
+		}
+		manifests = append(manifests, cdk8s.ApiObject_Of(construct).ToJson().(map[string]interface{}))
+	}
+
+	return manifests
+}
+
+// All returns the manifests of the given kind.
+func All(manifests []map[string]interface{}, kind string) []map[string]interface{} {
+
+	result := []map[string]interface{}{}
+
+	for _, manifest := range manifests {
+		if manifest["kind"] == kind {
+			result = append(result, manifest)
+		}
+	}
+
+	return result
+}
+
+// TB is the part of testing.TB that Find uses, so the package does not
+// import testing outside of tests.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
+
+// Find returns the only manifest of the given kind and fails the test when
+// there is not exactly one.
+func Find(t TB, manifests []map[string]interface{}, kind string) map[string]interface{} {
+
+	t.Helper()
+
+	result := All(manifests, kind)
+	if len(result) != 1 {
+		t.Fatalf("expected one %s, got %d", kind, len(result))
+	}
+
+	return result[0]
+}
+
+// Field walks a manifest along map keys and list indexes. It returns nil when
+// the path does not exist.
+func Field(value interface{}, path ...interface{}) interface{} {
+
+	for _, step := range path {
+		switch key := step.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			value = object[key]
+		case int:
+			list, ok := value.([]interface{})
+			if !ok || key >= len(list) {
+				return nil
+			}
+			value = list[key]
+		default:
+			return nil
+		}
+	}
+
+	return value
+}
+
+// Panics runs the function and returns the value it panicked with, or nil.
+func Panics(f func()) (recovered interface{}) {
+
+	defer func() {
+		recovered = recover()
+	}()
+
+	f()
+
+	return nil
+}
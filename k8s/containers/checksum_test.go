@@ -0,0 +1,110 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+// renderKubeChecksum renders a deployment mounting a secret and reading a
+// variable from a config map, and returns its pod template annotations.
+func renderKubeChecksum(t *testing.T, secretValue string, configValue string, skipSecret bool) interface{} {
+
+	app, chart := synth.NewChart("apps")
+
+	secret := k8s.NewKubeSecret(chart, jsii.String("secret"), &k8s.KubeSecretProps{
+		StringData: &map[string]*string{"token": jsii.String(secretValue)},
+	})
+	if skipSecret {
+		KubeSkipChecksum(secret)
+	}
+
+	configMap := k8s.NewKubeConfigMap(chart, jsii.String("config"), &k8s.KubeConfigMapProps{
+		Data: &map[string]*string{"LEVEL": jsii.String(configValue)},
+	})
+
+	deployment := k8s.NewKubeDeployment(chart, jsii.String("deployment"), &k8s.KubeDeploymentProps{
+		Spec: &k8s.DeploymentSpec{
+			Selector: &k8s.LabelSelector{
+				MatchLabels: &map[string]*string{"io.service": jsii.String("api")},
+			},
+			Template: &k8s.PodTemplateSpec{
+				Metadata: &k8s.ObjectMeta{
+					Labels:      &map[string]*string{"io.service": jsii.String("api")},
+					Annotations: &map[string]*string{"example.com/owner": jsii.String("team")},
+				},
+				Spec: &k8s.PodSpec{
+					Containers: &[]*k8s.Container{
+						{
+							Name:  jsii.String("api"),
+							Image: jsii.String("nginx"),
+							Env: &[]*k8s.EnvVar{
+								{
+									Name: jsii.String("LEVEL"),
+									ValueFrom: &k8s.EnvVarSource{
+										ConfigMapKeyRef: &k8s.ConfigMapKeySelector{
+											Name: configMap.Name(),
+											Key:  jsii.String("LEVEL"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Volumes: &[]*k8s.Volume{
+						{
+							Name:   jsii.String("secret"),
+							Secret: &k8s.SecretVolumeSource{SecretName: secret.Name()},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	KubeAttachChecksum(deployment, "/spec/template/spec")
+
+	// Повторный синтез не должен добавлять патч ещё раз
+	app.SynthYaml()
+
+	deploymentManifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	return synth.Field(deploymentManifest, "spec", "template", "metadata", "annotations")
+}
+
+func TestKubeChecksum(t *testing.T) {
+
+	annotations := renderKubeChecksum(t, "a", "info", false)
+
+	checksum := synth.Field(annotations, "checksum/config")
+	if checksum == nil {
+		t.Fatal("expected a checksum annotation")
+	}
+	if owner := synth.Field(annotations, "example.com/owner"); owner != "team" {
+		t.Errorf("expected the existing annotations kept, got %v", owner)
+	}
+
+	if again := synth.Field(renderKubeChecksum(t, "a", "info", false), "checksum/config"); again != checksum {
+		t.Errorf("expected a stable checksum, got %v and %v", checksum, again)
+	}
+	if secretChanged := synth.Field(renderKubeChecksum(t, "b", "info", false), "checksum/config"); secretChanged == checksum {
+		t.Error("expected the checksum to follow the secret")
+	}
+	if configChanged := synth.Field(renderKubeChecksum(t, "a", "debug", false), "checksum/config"); configChanged == checksum {
+		t.Error("expected the checksum to follow the config map")
+	}
+}
+
+func TestKubeSkipChecksum(t *testing.T) {
+
+	checksum := synth.Field(renderKubeChecksum(t, "a", "info", true), "checksum/config")
+
+	if checksum == nil {
+		t.Fatal("expected the config map to keep a checksum")
+	}
+	if secretChanged := synth.Field(renderKubeChecksum(t, "b", "info", true), "checksum/config"); secretChanged != checksum {
+		t.Errorf("expected a skipped secret to keep the checksum, got %v and %v", checksum, secretChanged)
+	}
+}
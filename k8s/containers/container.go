@@ -0,0 +1,127 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubeContainerProps describes an init or sidecar container running next to
+// the main container of a workload. A Native sidecar is rendered as an init
+// container with restartPolicy Always.
+type KubeContainerProps struct {
+	Name            *string
+	Image           *string
+	ImagePullPolicy *string
+	Command         *[]*string
+	Args            *[]*string
+	Ports           *[]*KubePort
+	Variables       *map[string]*string
+	Environment     *map[string]*k8s.EnvVarSource
+	EnvFrom         *[]*k8s.EnvFromSource
+	Volumes         *map[string]*k8s.Volume
+	Mounts          *map[string]*KubeMount
+	Resources       *k8s.ResourceRequirements
+	Native          *bool
+}
+
+func (props *KubeContainerProps) defaultProps() {
+	if props.Ports == nil {
+		props.Ports = &[]*KubePort{}
+	}
+	if props.Variables == nil {
+		props.Variables = &map[string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[string]*k8s.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &k8s.ResourceRequirements{}
+	}
+	if props.Native == nil {
+		props.Native = jsii.Bool(false)
+	}
+}
+
+func (props *KubeContainerProps) container() *k8s.Container {
+
+	variables := KubeEnv(props.Variables, props.Environment)
+
+	mounts := []*k8s.VolumeMount{}
+
+	for _, path := range KubeSortedKeys(*props.Volumes) {
+		volume := (*props.Volumes)[path]
+		mounts = append(mounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      volume.Name,
+		})
+	}
+
+	subMounts, _ := KubeMounts(props.Mounts)
+
+	mounts = append(mounts, subMounts...)
+
+	container := &k8s.Container{
+		Name:            props.Name,
+		Image:           props.Image,
+		ImagePullPolicy: props.ImagePullPolicy,
+		Command:         props.Command,
+		Args:            props.Args,
+		Resources:       props.Resources,
+		Ports:           KubeContainerPorts(*props.Ports),
+		SecurityContext: &k8s.SecurityContext{
+			RunAsNonRoot: jsii.Bool(false),
+		},
+		Env:          &variables,
+		EnvFrom:      props.EnvFrom,
+		VolumeMounts: &mounts,
+	}
+
+	if *props.Native {
+		container.RestartPolicy = jsii.String("Always")
+	}
+
+	return container
+}
+
+// KubeContainers renders init and sidecar containers. Native sidecars start
+// ahead of the regular init containers, so those can already rely on them.
+// The returned volumes are the ones mounted by these containers.
+func KubeContainers(
+	initContainers *[]*KubeContainerProps,
+	sidecars *[]*KubeContainerProps,
+) ([]*k8s.Container, []*k8s.Container, []*k8s.Volume) {
+
+	inits := []*k8s.Container{}
+	containers := []*k8s.Container{}
+	volumes := []*k8s.Volume{}
+
+	if sidecars != nil {
+		for _, props := range *sidecars {
+			props.defaultProps()
+			if *props.Native {
+				inits = append(inits, props.container())
+			} else {
+				containers = append(containers, props.container())
+			}
+			for _, path := range KubeSortedKeys(*props.Volumes) {
+				volumes = append(volumes, (*props.Volumes)[path])
+			}
+			_, mountVolumes := KubeMounts(props.Mounts)
+			volumes = append(volumes, mountVolumes...)
+		}
+	}
+
+	if initContainers != nil {
+		for _, props := range *initContainers {
+			props.defaultProps()
+			inits = append(inits, props.container())
+			for _, path := range KubeSortedKeys(*props.Volumes) {
+				volumes = append(volumes, (*props.Volumes)[path])
+			}
+			_, mountVolumes := KubeMounts(props.Mounts)
+			volumes = append(volumes, mountVolumes...)
+		}
+	}
+
+	return inits, containers, volumes
+}
@@ -0,0 +1,74 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+func KubeEnvSource_FromSecret(name string, key string) *k8s.EnvVarSource {
+	return &k8s.EnvVarSource{
+		SecretKeyRef: &k8s.SecretKeySelector{Name: jsii.String(name), Key: jsii.String(key)},
+	}
+}
+
+func KubeEnvSource_FromConfigMap(name string, key string) *k8s.EnvVarSource {
+	return &k8s.EnvVarSource{
+		ConfigMapKeyRef: &k8s.ConfigMapKeySelector{Name: jsii.String(name), Key: jsii.String(key)},
+	}
+}
+
+// KubeEnvSource_FromField exposes a pod field such as metadata.name,
+// metadata.namespace, spec.nodeName or status.podIP.
+func KubeEnvSource_FromField(path string) *k8s.EnvVarSource {
+	return &k8s.EnvVarSource{
+		FieldRef: &k8s.ObjectFieldSelector{FieldPath: jsii.String(path)},
+	}
+}
+
+// KubeEnvSource_FromResource exposes a container resource such as
+// limits.cpu or requests.memory.
+func KubeEnvSource_FromResource(resource string) *k8s.EnvVarSource {
+	return &k8s.EnvVarSource{
+		ResourceFieldRef: &k8s.ResourceFieldSelector{Resource: jsii.String(resource)},
+	}
+}
+
+func KubeEnvFrom_FromSecret(name string, prefix *string) *k8s.EnvFromSource {
+	return &k8s.EnvFromSource{
+		SecretRef: &k8s.SecretEnvSource{Name: jsii.String(name)},
+		Prefix:    prefix,
+	}
+}
+
+func KubeEnvFrom_FromConfigMap(name string, prefix *string) *k8s.EnvFromSource {
+	return &k8s.EnvFromSource{
+		ConfigMapRef: &k8s.ConfigMapEnvSource{Name: jsii.String(name)},
+		Prefix:       prefix,
+	}
+}
+
+// KubeEnv renders literal variables followed by the referenced ones.
+func KubeEnv(variables *map[string]*string, environment *map[string]*k8s.EnvVarSource) []*k8s.EnvVar {
+
+	env := []*k8s.EnvVar{}
+
+	if variables != nil {
+		for _, k := range KubeSortedKeys(*variables) {
+			env = append(env, &k8s.EnvVar{
+				Name:  jsii.String(k),
+				Value: (*variables)[k],
+			})
+		}
+	}
+
+	if environment != nil {
+		for _, k := range KubeSortedKeys(*environment) {
+			env = append(env, &k8s.EnvVar{
+				Name:      jsii.String(k),
+				ValueFrom: (*environment)[k],
+			})
+		}
+	}
+
+	return env
+}
@@ -0,0 +1,31 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+func TestKubeEnv(t *testing.T) {
+
+	env := KubeEnv(&map[string]*string{
+		"MODE": jsii.String("production"),
+	}, &map[string]*k8s.EnvVarSource{
+		"POD_NAME":    KubeEnvSource_FromField("metadata.name"),
+		"DB_PASSWORD": KubeEnvSource_FromSecret("db", "password"),
+	})
+
+	if len(env) != 3 {
+		t.Fatalf("expected three variables, got %d", len(env))
+	}
+	if *env[0].Name != "MODE" || *env[0].Value != "production" {
+		t.Errorf("expected the literal variable first, got %s", *env[0].Name)
+	}
+	if *env[1].Name != "DB_PASSWORD" || *env[1].ValueFrom.SecretKeyRef.Key != "password" {
+		t.Errorf("expected the secret variable, got %s", *env[1].Name)
+	}
+	if *env[2].Name != "POD_NAME" || *env[2].ValueFrom.FieldRef.FieldPath != "metadata.name" {
+		t.Errorf("expected the field variable, got %s", *env[2].Name)
+	}
+}
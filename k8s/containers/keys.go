@@ -0,0 +1,19 @@
+package cdk8skit
+
+import "sort"
+
+// KubeSortedKeys returns the keys of the map in order. Go maps iterate in a
+// random order, and rendering them as is would change the pod template, and
+// roll the pods out, on every synth.
+func KubeSortedKeys[T any](values map[string]T) []string {
+
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
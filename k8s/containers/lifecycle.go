@@ -0,0 +1,76 @@
+package cdk8skit
+
+import (
+	"strconv"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+func KubeHandler_FromCommand(command *[]*string) *k8s.LifecycleHandler {
+	return &k8s.LifecycleHandler{
+		Exec: &k8s.ExecAction{
+			Command: command,
+		},
+	}
+}
+
+// KubeHandler_FromHttpPath sends a GET request to the container. When port is
+// nil the request targets the container port of the workload.
+func KubeHandler_FromHttpPath(path *string, port *float64) *k8s.LifecycleHandler {
+
+	handler := &k8s.LifecycleHandler{
+		HttpGet: &k8s.HttpGetAction{
+			Path: path,
+		},
+	}
+	if port != nil {
+		handler.HttpGet.Port = k8s.IntOrString_FromNumber(port)
+	}
+
+	return handler
+}
+
+// KubeHandler_FromSleep pauses the container for the given number of seconds,
+// typically as a preStop hook letting in-flight requests drain before SIGTERM.
+func KubeHandler_FromSleep(seconds *float64) *k8s.LifecycleHandler {
+
+	// Действие sleep появилось в Kubernetes 1.29 и отсутствует в схеме 1.28,
+	// поэтому пауза выполняется командой, которая должна быть в образе
+	return KubeHandler_FromCommand(&[]*string{
+		jsii.String("sleep"),
+		jsii.String(strconv.FormatFloat(*seconds, 'f', -1, 64)),
+	})
+}
+
+// KubeLifecycle returns the lifecycle of a container, or nil without hooks.
+// HTTP hooks without an explicit port target the given container port.
+func KubeLifecycle(postStart *k8s.LifecycleHandler, preStop *k8s.LifecycleHandler, port *float64) *k8s.Lifecycle {
+
+	if postStart == nil && preStop == nil {
+		return nil
+	}
+
+	return &k8s.Lifecycle{
+		PostStart: kubeHandlerWithDefaultPort(postStart, port),
+		PreStop:   kubeHandlerWithDefaultPort(preStop, port),
+	}
+}
+
+func kubeHandlerWithDefaultPort(handler *k8s.LifecycleHandler, port *float64) *k8s.LifecycleHandler {
+
+	if handler == nil || handler.HttpGet == nil || handler.HttpGet.Port != nil {
+		return handler
+	}
+
+	if port == nil {
+		panic("Не указан порт HTTP обработчика")
+	}
+
+	result := *handler
+	action := *result.HttpGet
+	action.Port = k8s.IntOrString_FromNumber(port)
+	result.HttpGet = &action
+
+	return &result
+}
@@ -0,0 +1,58 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeLifecycleWithoutHooks(t *testing.T) {
+
+	if lifecycle := KubeLifecycle(nil, nil, jsii.Number(8080)); lifecycle != nil {
+		t.Errorf("expected no lifecycle without hooks, got %v", lifecycle)
+	}
+}
+
+func TestKubeLifecycleDefaultPort(t *testing.T) {
+
+	preStop := KubeHandler_FromHttpPath(jsii.String("/drain"), nil)
+
+	lifecycle := KubeLifecycle(nil, preStop, jsii.Number(8080))
+
+	if port := lifecycle.PreStop.HttpGet.Port.Value(); port != float64(8080) {
+		t.Errorf("expected the hook on the container port 8080, got %v", port)
+	}
+	if preStop.HttpGet.Port != nil {
+		t.Errorf("expected the caller's handler to stay unchanged")
+	}
+}
+
+func TestKubeLifecycleExplicitPort(t *testing.T) {
+
+	preStop := KubeHandler_FromHttpPath(jsii.String("/drain"), jsii.Number(9000))
+
+	lifecycle := KubeLifecycle(nil, preStop, jsii.Number(8080))
+
+	if port := lifecycle.PreStop.HttpGet.Port.Value(); port != float64(9000) {
+		t.Errorf("expected the hook on 9000, got %v", port)
+	}
+}
+
+func TestKubeLifecycleRequiresPort(t *testing.T) {
+
+	preStop := KubeHandler_FromHttpPath(jsii.String("/drain"), nil)
+
+	if synth.Panics(func() { KubeLifecycle(nil, preStop, nil) }) == nil {
+		t.Errorf("expected a panic for an HTTP hook without a port")
+	}
+}
+
+func TestKubeHandlerFromSleep(t *testing.T) {
+
+	handler := KubeHandler_FromSleep(jsii.Number(2.5))
+
+	if command := *handler.Exec.Command; *command[0] != "sleep" || *command[1] != "2.5" {
+		t.Errorf("expected sleep 2.5, got %v %v", *command[0], *command[1])
+	}
+}
@@ -0,0 +1,38 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubeMount places a volume, or a single file of it when SubPath is set, at a
+// path of the container.
+type KubeMount struct {
+	Volume   *k8s.Volume
+	SubPath  *string
+	ReadOnly *bool
+}
+
+// KubeMounts renders the volume mounts together with the volumes they need.
+func KubeMounts(mounts *map[string]*KubeMount) ([]*k8s.VolumeMount, []*k8s.Volume) {
+
+	volumeMounts := []*k8s.VolumeMount{}
+	volumes := []*k8s.Volume{}
+
+	if mounts == nil {
+		return volumeMounts, volumes
+	}
+
+	for _, path := range KubeSortedKeys(*mounts) {
+		mount := (*mounts)[path]
+		volumeMounts = append(volumeMounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      mount.Volume.Name,
+			SubPath:   mount.SubPath,
+			ReadOnly:  mount.ReadOnly,
+		})
+		volumes = append(volumes, mount.Volume)
+	}
+
+	return volumeMounts, volumes
+}
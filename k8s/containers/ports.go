@@ -0,0 +1,74 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubePort maps a container port to a Service port. The first port of a list
+// is the primary one: probes target it unless told otherwise.
+type KubePort struct {
+	Name          *string
+	Port          *float64
+	ContainerPort *float64
+	Protocol      *string
+	AppProtocol   *string
+}
+
+func (port *KubePort) defaultProps() {
+	if port.Port == nil && port.ContainerPort == nil {
+		panic("Не указан номер порта")
+	}
+	if port.Port == nil {
+		port.Port = port.ContainerPort
+	}
+	if port.ContainerPort == nil {
+		port.ContainerPort = port.Port
+	}
+}
+
+// ServiceName is the Service port name, the port number when no name is set.
+// Without a Port the Service port takes the number of the container port.
+func (port *KubePort) ServiceName() *string {
+	port.defaultProps()
+	if port.Name != nil {
+		return port.Name
+	}
+	return jsii.String(fmt.Sprintf("%d", int(*port.Port)))
+}
+
+func KubeServicePorts(ports []*KubePort) *[]*k8s.ServicePort {
+
+	servicePorts := []*k8s.ServicePort{}
+
+	for _, port := range ports {
+		port.defaultProps()
+		servicePorts = append(servicePorts, &k8s.ServicePort{
+			Name:        port.ServiceName(),
+			Port:        port.Port,
+			TargetPort:  k8s.IntOrString_FromNumber(port.ContainerPort),
+			Protocol:    port.Protocol,
+			AppProtocol: port.AppProtocol,
+		})
+	}
+
+	return &servicePorts
+}
+
+func KubeContainerPorts(ports []*KubePort) *[]*k8s.ContainerPort {
+
+	containerPorts := []*k8s.ContainerPort{}
+
+	for _, port := range ports {
+		port.defaultProps()
+		containerPorts = append(containerPorts, &k8s.ContainerPort{
+			ContainerPort: port.ContainerPort,
+			Name:          port.Name,
+			Protocol:      port.Protocol,
+		})
+	}
+
+	return &containerPorts
+}
@@ -0,0 +1,58 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubePorts(t *testing.T) {
+
+	ports := []*KubePort{
+		{Name: jsii.String("http"), Port: jsii.Number(80), ContainerPort: jsii.Number(8080)},
+		{Name: jsii.String("grpc"), Port: jsii.Number(9090), ContainerPort: jsii.Number(9000), AppProtocol: jsii.String("kubernetes.io/h2c")},
+		{ContainerPort: jsii.Number(5353), Protocol: jsii.String("UDP")},
+	}
+
+	servicePorts := *KubeServicePorts(ports)
+	containerPorts := *KubeContainerPorts(ports)
+
+	expected := []struct {
+		name          string
+		port          float64
+		containerPort float64
+	}{
+		{"http", 80, 8080},
+		{"grpc", 9090, 9000},
+		{"5353", 5353, 5353},
+	}
+
+	for i, port := range expected {
+		if name := *servicePorts[i].Name; name != port.name {
+			t.Errorf("service port %d: expected name %s, got %s", i, port.name, name)
+		}
+		if number := *servicePorts[i].Port; number != port.port {
+			t.Errorf("service port %d: expected %v, got %v", i, port.port, number)
+		}
+		if number := *containerPorts[i].ContainerPort; number != port.containerPort {
+			t.Errorf("container port %d: expected %v, got %v", i, port.containerPort, number)
+		}
+	}
+
+	if protocol := servicePorts[1].AppProtocol; protocol == nil || *protocol != "kubernetes.io/h2c" {
+		t.Errorf("expected the grpc app protocol, got %v", protocol)
+	}
+	if protocol := containerPorts[2].Protocol; protocol == nil || *protocol != "UDP" {
+		t.Errorf("expected the UDP container port, got %v", protocol)
+	}
+}
+
+func TestKubePortRequiresNumber(t *testing.T) {
+
+	if synth.Panics(func() {
+		(&KubePort{Name: jsii.String("metrics")}).ServiceName()
+	}) == nil {
+		t.Error("expected a panic for a port without a number")
+	}
+}
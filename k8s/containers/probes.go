@@ -0,0 +1,129 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubeProbeOptions is shared by the probe shorthands. When Port is nil the probe
+// targets the container port of the workload it is attached to.
+type KubeProbeOptions struct {
+	Port                *float64
+	FailureThreshold    *float64
+	SuccessThreshold    *float64
+	InitialDelaySeconds *float64
+	PeriodSeconds       *float64
+	TimeoutSeconds      *float64
+}
+
+func (options *KubeProbeOptions) defaultProps() {
+	if options.FailureThreshold == nil {
+		options.FailureThreshold = jsii.Number(3)
+	}
+	if options.PeriodSeconds == nil {
+		options.PeriodSeconds = jsii.Number(10)
+	}
+	if options.TimeoutSeconds == nil {
+		options.TimeoutSeconds = jsii.Number(1)
+	}
+}
+
+func (options *KubeProbeOptions) probe() *k8s.Probe {
+	return &k8s.Probe{
+		FailureThreshold:    options.FailureThreshold,
+		SuccessThreshold:    options.SuccessThreshold,
+		InitialDelaySeconds: options.InitialDelaySeconds,
+		PeriodSeconds:       options.PeriodSeconds,
+		TimeoutSeconds:      options.TimeoutSeconds,
+	}
+}
+
+func KubeProbe_FromHttpPath(path *string, options *KubeProbeOptions) *k8s.Probe {
+
+	options.defaultProps()
+
+	probe := options.probe()
+	probe.HttpGet = &k8s.HttpGetAction{
+		Path: path,
+	}
+	if options.Port != nil {
+		probe.HttpGet.Port = k8s.IntOrString_FromNumber(options.Port)
+	}
+
+	return probe
+}
+
+func KubeProbe_FromTcp(options *KubeProbeOptions) *k8s.Probe {
+
+	options.defaultProps()
+
+	probe := options.probe()
+	probe.TcpSocket = &k8s.TcpSocketAction{}
+	if options.Port != nil {
+		probe.TcpSocket.Port = k8s.IntOrString_FromNumber(options.Port)
+	}
+
+	return probe
+}
+
+func KubeProbe_FromCommand(command *[]*string, options *KubeProbeOptions) *k8s.Probe {
+
+	options.defaultProps()
+
+	probe := options.probe()
+	probe.Exec = &k8s.ExecAction{
+		Command: command,
+	}
+
+	return probe
+}
+
+func KubeProbe_FromGrpc(service *string, options *KubeProbeOptions) *k8s.Probe {
+
+	options.defaultProps()
+
+	probe := options.probe()
+	probe.Grpc = &k8s.GrpcAction{
+		Port:    options.Port,
+		Service: service,
+	}
+
+	return probe
+}
+
+// KubeProbe_WithDefaultPort points a probe without an explicit port at the given container port.
+// It panics when the probe needs a port and there is none.
+func KubeProbe_WithDefaultPort(probe *k8s.Probe, port *float64) *k8s.Probe {
+
+	if probe == nil {
+		return nil
+	}
+
+	result := *probe
+
+	needsPort := (result.HttpGet != nil && result.HttpGet.Port == nil) ||
+		(result.TcpSocket != nil && result.TcpSocket.Port == nil) ||
+		(result.Grpc != nil && result.Grpc.Port == nil)
+
+	if needsPort && port == nil {
+		panic("Не указан порт пробы")
+	}
+
+	if result.HttpGet != nil && result.HttpGet.Port == nil {
+		action := *result.HttpGet
+		action.Port = k8s.IntOrString_FromNumber(port)
+		result.HttpGet = &action
+	}
+	if result.TcpSocket != nil && result.TcpSocket.Port == nil {
+		action := *result.TcpSocket
+		action.Port = k8s.IntOrString_FromNumber(port)
+		result.TcpSocket = &action
+	}
+	if result.Grpc != nil && result.Grpc.Port == nil {
+		action := *result.Grpc
+		action.Port = port
+		result.Grpc = &action
+	}
+
+	return &result
+}
@@ -0,0 +1,79 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func renderProbe(t *testing.T, probe *k8s.Probe) interface{} {
+
+	app, chart := synth.NewChart("")
+
+	k8s.NewKubePod(chart, jsii.String("pod"), &k8s.KubePodProps{
+		Spec: &k8s.PodSpec{
+			Containers: &[]*k8s.Container{
+				{
+					Name:          jsii.String("app"),
+					Image:         jsii.String("nginx"),
+					LivenessProbe: probe,
+				},
+			},
+		},
+	})
+
+	return synth.Field(synth.Find(t, synth.Manifests(app), "Pod"), "spec", "containers", 0, "livenessProbe")
+}
+
+func TestKubeGrpcProbeDefaultPort(t *testing.T) {
+
+	probe := KubeProbe_FromGrpc(jsii.String("health"), &KubeProbeOptions{})
+
+	liveness := renderProbe(t, KubeProbe_WithDefaultPort(probe, jsii.Number(8080)))
+
+	if port := synth.Field(liveness, "grpc", "port"); port != float64(8080) {
+		t.Errorf("expected the container port 8080, got %v", port)
+	}
+	if service := synth.Field(liveness, "grpc", "service"); service != "health" {
+		t.Errorf("expected the health service, got %v", service)
+	}
+	if probe.Grpc.Port != nil {
+		t.Error("expected the original probe to stay without a port")
+	}
+}
+
+func TestKubeGrpcProbeExplicitPort(t *testing.T) {
+
+	probe := KubeProbe_FromGrpc(nil, &KubeProbeOptions{
+		Port: jsii.Number(9000),
+	})
+
+	liveness := renderProbe(t, KubeProbe_WithDefaultPort(probe, jsii.Number(8080)))
+
+	if port := synth.Field(liveness, "grpc", "port"); port != float64(9000) {
+		t.Errorf("expected the probe port 9000, got %v", port)
+	}
+}
+
+func TestKubeProbeWithoutPort(t *testing.T) {
+
+	probes := map[string]*k8s.Probe{
+		"grpc": KubeProbe_FromGrpc(nil, &KubeProbeOptions{}),
+		"http": KubeProbe_FromHttpPath(jsii.String("/healthz"), &KubeProbeOptions{}),
+		"tcp":  KubeProbe_FromTcp(&KubeProbeOptions{}),
+	}
+
+	for name, probe := range probes {
+		if synth.Panics(func() { KubeProbe_WithDefaultPort(probe, nil) }) == nil {
+			t.Errorf("%s: expected a panic for a probe without a port", name)
+		}
+	}
+
+	command := KubeProbe_FromCommand(&[]*string{jsii.String("true")}, &KubeProbeOptions{})
+
+	if synth.Panics(func() { KubeProbe_WithDefaultPort(command, nil) }) != nil {
+		t.Error("expected a command probe to need no port")
+	}
+}
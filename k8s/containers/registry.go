@@ -0,0 +1,270 @@
+package cdk8skit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+const registryLabel = "io.registry"
+
+// KubeRegistrySecretProps holds the credentials of a private registry,
+// docker.io when Registry is nil.
+type KubeRegistrySecretProps struct {
+	Registry *string
+	Username *string
+	Password *string
+	Email    *string
+}
+
+func (props *KubeRegistrySecretProps) defaultProps() {
+	if props.Registry == nil {
+		props.Registry = jsii.String(defaultRegistry)
+	}
+	if props.Username == nil || props.Password == nil {
+		panic(fmt.Sprintf("Не указаны учётные данные реестра %s", *props.Registry))
+	}
+}
+
+// Значение метки не может содержать двоеточие порта
+func registryLabelValue(registry string) *string {
+	return jsii.String(strings.ReplaceAll(registry, ":", "-"))
+}
+
+func registryServer(registry string) string {
+	if registry == defaultRegistry {
+		return "https://index.docker.io/v1/"
+	}
+	return registry
+}
+
+func registryOfServer(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server = strings.SplitN(server, "/", 2)[0]
+	if server == "index.docker.io" || server == "registry-1.docker.io" {
+		return defaultRegistry
+	}
+	return server
+}
+
+func newKubeRegistrySecret(scope constructs.Construct, id string, registry string, auth interface{}) k8s.KubeSecret {
+
+	config, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			registryServer(registry): auth,
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при формировании конфигурации реестра: %s", err))
+	}
+
+	return k8s.NewKubeSecret(scope, jsii.String(id), &k8s.KubeSecretProps{
+		Metadata: &k8s.ObjectMeta{
+			Labels: &map[string]*string{
+				registryLabel: registryLabelValue(registry),
+			},
+		},
+		Type: jsii.String("kubernetes.io/dockerconfigjson"),
+		StringData: &map[string]*string{
+			".dockerconfigjson": jsii.String(string(config)),
+		},
+	})
+}
+
+// NewKubeRegistrySecret creates a kubernetes.io/dockerconfigjson secret.
+// Workloads in the same namespace pull their images of this registry with it.
+func NewKubeRegistrySecret(scope constructs.Construct, id string, props *KubeRegistrySecretProps) k8s.KubeSecret {
+
+	props.defaultProps()
+
+	auth := map[string]interface{}{
+		"username": props.Username,
+		"password": props.Password,
+		"auth": base64.StdEncoding.EncodeToString(
+			[]byte(fmt.Sprintf("%s:%s", *props.Username, *props.Password)),
+		),
+	}
+
+	if props.Email != nil {
+		auth["email"] = props.Email
+	}
+
+	return newKubeRegistrySecret(scope, id, *props.Registry, auth)
+}
+
+// KubeRegistrySecret_FromDockerConfig copies the credentials of the registry
+// from a docker config file, ~/.docker/config.json when path is nil.
+// Credentials kept by a credential helper are not in the file and cannot be
+// copied.
+func KubeRegistrySecret_FromDockerConfig(scope constructs.Construct, id string, registry *string, path *string) k8s.KubeSecret {
+
+	if registry == nil {
+		registry = jsii.String(defaultRegistry)
+	}
+
+	if path == nil {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка при определении домашнего каталога: %s", err))
+		}
+		path = jsii.String(filepath.Join(home, ".docker", "config.json"))
+	}
+
+	content, err := os.ReadFile(*path)
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при чтении файла: %s", err))
+	}
+
+	var config struct {
+		Auths map[string]json.RawMessage `json:"auths"`
+	}
+
+	if err := json.Unmarshal(content, &config); err != nil {
+		panic(fmt.Sprintf("Ошибка при разборе %s: %s", *path, err))
+	}
+
+	for server, auth := range config.Auths {
+		if registryOfServer(server) != *registry {
+			continue
+		}
+		var value map[string]interface{}
+		if err := json.Unmarshal(auth, &value); err != nil {
+			panic(fmt.Sprintf("Ошибка при разборе %s: %s", *path, err))
+		}
+		if _, ok := value["auth"]; !ok {
+			panic(fmt.Sprintf("Учётные данные реестра %s в %s хранятся вне файла", *registry, *path))
+		}
+		return newKubeRegistrySecret(scope, id, *registry, value)
+	}
+
+	panic(fmt.Sprintf("Реестр %s не найден в %s", *registry, *path))
+}
+
+func sameNamespace(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// registrySecrets finds the registry secrets in the namespace of the scope,
+// keyed by the label of their registry.
+func registrySecrets(scope constructs.Construct) map[string]*string {
+
+	namespace := cdk8s.Chart_Of(scope).Namespace()
+
+	secrets := map[string]*string{}
+
+	for _, construct := range *scope.Node().Root().Node().FindAll(constructs.ConstructOrder_PREORDER) {
+		if !*cdk8s.ApiObject_IsApiObject(construct) {
+			continue
+		}
+		object := cdk8s.ApiObject_Of(construct)
+		registry := object.Metadata().GetLabel(jsii.String(registryLabel))
+		if registry == nil || *object.Kind() != "Secret" {
+			continue
+		}
+		if !sameNamespace(object.Metadata().Namespace(), namespace) {
+			continue
+		}
+		secrets[*registry] = object.Name()
+	}
+
+	return secrets
+}
+
+// KubePullSecrets returns the registry secrets the images are pulled with.
+func KubePullSecrets(scope constructs.Construct, images []*string) *[]*k8s.LocalObjectReference {
+
+	secrets := registrySecrets(scope)
+
+	references := []*k8s.LocalObjectReference{}
+	seen := map[string]bool{}
+
+	for _, image := range images {
+		if image == nil {
+			continue
+		}
+		registry := *registryLabelValue(KubeImage_Parse(*image).registry())
+		name, ok := secrets[registry]
+		if !ok || seen[*name] {
+			continue
+		}
+		seen[*name] = true
+		references = append(references, &k8s.LocalObjectReference{Name: name})
+	}
+
+	return &references
+}
+
+type podPullSecrets struct {
+	apiObject cdk8s.ApiObject
+	path      []string
+}
+
+// Validate is called right before rendering, once every registry secret of
+// the app exists, and adds those the images of the pod are pulled with.
+func (pullSecrets *podPullSecrets) Validate() *[]*string {
+
+	spec := lookup(pullSecrets.apiObject.ToJson(), pullSecrets.path...)
+
+	images := []*string{}
+
+	for _, container := range append(items(lookup(spec, "containers")), items(lookup(spec, "initContainers"))...) {
+		if image, ok := lookup(container, "image").(string); ok {
+			images = append(images, jsii.String(image))
+		}
+	}
+
+	references := []interface{}{}
+	seen := map[string]bool{}
+
+	for _, reference := range items(lookup(spec, "imagePullSecrets")) {
+		if name, ok := lookup(reference, "name").(string); ok {
+			seen[name] = true
+		}
+		references = append(references, reference)
+	}
+
+	added := false
+
+	for _, reference := range *KubePullSecrets(pullSecrets.apiObject, images) {
+		if seen[*reference.Name] {
+			continue
+		}
+		seen[*reference.Name] = true
+		references = append(references, map[string]interface{}{"name": *reference.Name})
+		added = true
+	}
+
+	// Синтез может вызываться несколько раз, а секреты, добавленные прошлым вызовом, уже есть в спецификации
+	if !added {
+		return &[]*string{}
+	}
+
+	pullSecrets.apiObject.AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String("/"+strings.Join(append(pullSecrets.path, "imagePullSecrets"), "/")),
+		&references,
+	))
+
+	return &[]*string{}
+}
+
+// KubeAttachPullSecrets sets imagePullSecrets of the pod whose spec lives at
+// the given path for the images of all its containers. The secrets are looked
+// up when the app is rendered, so a registry secret created after the
+// workload is used as well.
+func KubeAttachPullSecrets(apiObject cdk8s.ApiObject, path string) {
+	apiObject.Node().AddValidation(&podPullSecrets{
+		apiObject: apiObject,
+		path:      strings.Split(strings.Trim(path, "/"), "/"),
+	})
+}
@@ -0,0 +1,93 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubePullSecrets(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	deployment := k8s.NewKubeDeployment(chart, jsii.String("deployment"), &k8s.KubeDeploymentProps{
+		Spec: &k8s.DeploymentSpec{
+			Selector: &k8s.LabelSelector{
+				MatchLabels: &map[string]*string{"io.service": jsii.String("api")},
+			},
+			Template: &k8s.PodTemplateSpec{
+				Metadata: &k8s.ObjectMeta{
+					Labels: &map[string]*string{"io.service": jsii.String("api")},
+				},
+				Spec: &k8s.PodSpec{
+					Containers: &[]*k8s.Container{
+						{Name: jsii.String("api"), Image: jsii.String("registry.example.com:5000/team/api:1.0")},
+						{Name: jsii.String("proxy"), Image: jsii.String("nginx")},
+					},
+					ImagePullSecrets: &[]*k8s.LocalObjectReference{
+						{Name: jsii.String("existing")},
+					},
+				},
+			},
+		},
+	})
+
+	KubeAttachPullSecrets(deployment, "/spec/template/spec")
+
+	// Секрет создаётся после рабочей нагрузки и всё равно должен попасть в неё
+	secret := NewKubeRegistrySecret(chart, "registry", &KubeRegistrySecretProps{
+		Registry: jsii.String("registry.example.com:5000"),
+		Username: jsii.String("user"),
+		Password: jsii.String("password"),
+	})
+
+	app.SynthYaml()
+
+	manifest := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	references := synth.Field(manifest, "spec", "template", "spec", "imagePullSecrets")
+
+	if count := len(references.([]interface{})); count != 2 {
+		t.Fatalf("expected the existing and the registry pull secrets, got %v", references)
+	}
+	if name := synth.Field(references, 0, "name"); name != "existing" {
+		t.Errorf("expected the existing pull secret first, got %v", name)
+	}
+	if name := synth.Field(references, 1, "name"); name != *secret.Name() {
+		t.Errorf("expected the registry pull secret %s, got %v", *secret.Name(), name)
+	}
+}
+
+func TestKubePullSecretsOtherRegistry(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	job := k8s.NewKubeJob(chart, jsii.String("job"), &k8s.KubeJobProps{
+		Spec: &k8s.JobSpec{
+			Template: &k8s.PodTemplateSpec{
+				Spec: &k8s.PodSpec{
+					RestartPolicy: jsii.String("Never"),
+					Containers: &[]*k8s.Container{
+						{Name: jsii.String("job"), Image: jsii.String("nginx")},
+					},
+				},
+			},
+		},
+	})
+
+	KubeAttachPullSecrets(job, "/spec/template/spec")
+
+	NewKubeRegistrySecret(chart, "registry", &KubeRegistrySecretProps{
+		Registry: jsii.String("registry.example.com"),
+		Username: jsii.String("user"),
+		Password: jsii.String("password"),
+	})
+
+	manifest := synth.Find(t, synth.Manifests(app), "Job")
+
+	if references := synth.Field(manifest, "spec", "template", "spec", "imagePullSecrets"); references != nil {
+		t.Errorf("expected no pull secrets for another registry, got %v", references)
+	}
+}
@@ -0,0 +1,80 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubeResourcesProps describes container requests and limits as Kubernetes quantities,
+// e.g. "250m" for CPU or "512Mi" for memory.
+type KubeResourcesProps struct {
+	CpuRequest              *string
+	CpuLimit                *string
+	MemoryRequest           *string
+	MemoryLimit             *string
+	EphemeralStorageRequest *string
+	EphemeralStorageLimit   *string
+}
+
+func NewKubeResources(props *KubeResourcesProps) *k8s.ResourceRequirements {
+
+	requests := make(map[string]k8s.Quantity)
+	limits := make(map[string]k8s.Quantity)
+
+	addQuantity(requests, "cpu", props.CpuRequest)
+	addQuantity(limits, "cpu", props.CpuLimit)
+	addQuantity(requests, "memory", props.MemoryRequest)
+	addQuantity(limits, "memory", props.MemoryLimit)
+	addQuantity(requests, "ephemeral-storage", props.EphemeralStorageRequest)
+	addQuantity(limits, "ephemeral-storage", props.EphemeralStorageLimit)
+
+	resources := &k8s.ResourceRequirements{}
+
+	if len(requests) > 0 {
+		resources.Requests = &requests
+	}
+	if len(limits) > 0 {
+		resources.Limits = &limits
+	}
+
+	return resources
+}
+
+func addQuantity(quantities map[string]k8s.Quantity, name string, value *string) {
+	if value != nil {
+		quantities[name] = k8s.Quantity_FromString(value)
+	}
+}
+
+func KubeResources_Small() *k8s.ResourceRequirements {
+	return NewKubeResources(&KubeResourcesProps{
+		CpuRequest:              jsii.String("100m"),
+		CpuLimit:                jsii.String("250m"),
+		MemoryRequest:           jsii.String("128Mi"),
+		MemoryLimit:             jsii.String("256Mi"),
+		EphemeralStorageRequest: jsii.String("1Gi"),
+		EphemeralStorageLimit:   jsii.String("2Gi"),
+	})
+}
+
+func KubeResources_Medium() *k8s.ResourceRequirements {
+	return NewKubeResources(&KubeResourcesProps{
+		CpuRequest:              jsii.String("250m"),
+		CpuLimit:                jsii.String("500m"),
+		MemoryRequest:           jsii.String("256Mi"),
+		MemoryLimit:             jsii.String("512Mi"),
+		EphemeralStorageRequest: jsii.String("2Gi"),
+		EphemeralStorageLimit:   jsii.String("4Gi"),
+	})
+}
+
+func KubeResources_Large() *k8s.ResourceRequirements {
+	return NewKubeResources(&KubeResourcesProps{
+		CpuRequest:              jsii.String("500m"),
+		CpuLimit:                jsii.String("1000m"),
+		MemoryRequest:           jsii.String("512Mi"),
+		MemoryLimit:             jsii.String("1Gi"),
+		EphemeralStorageRequest: jsii.String("4Gi"),
+		EphemeralStorageLimit:   jsii.String("8Gi"),
+	})
+}
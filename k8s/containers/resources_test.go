@@ -0,0 +1,61 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeResourcePresets(t *testing.T) {
+
+	presets := map[string]*k8s.ResourceRequirements{
+		"small":  KubeResources_Small(),
+		"medium": KubeResources_Medium(),
+		"large":  KubeResources_Large(),
+	}
+
+	// Значения совпадают с пресетами дерева cdk8s
+	expected := map[string]map[string]map[string]string{
+		"small": {
+			"requests": {"cpu": "100m", "memory": "128Mi", "ephemeral-storage": "1Gi"},
+			"limits":   {"cpu": "250m", "memory": "256Mi", "ephemeral-storage": "2Gi"},
+		},
+		"medium": {
+			"requests": {"cpu": "250m", "memory": "256Mi", "ephemeral-storage": "2Gi"},
+			"limits":   {"cpu": "500m", "memory": "512Mi", "ephemeral-storage": "4Gi"},
+		},
+		"large": {
+			"requests": {"cpu": "500m", "memory": "512Mi", "ephemeral-storage": "4Gi"},
+			"limits":   {"cpu": "1000m", "memory": "1Gi", "ephemeral-storage": "8Gi"},
+		},
+	}
+
+	for name, resources := range presets {
+		app, chart := synth.NewChart("")
+
+		k8s.NewKubePod(chart, jsii.String("pod"), &k8s.KubePodProps{
+			Spec: &k8s.PodSpec{
+				Containers: &[]*k8s.Container{
+					{
+						Name:      jsii.String("app"),
+						Image:     jsii.String("nginx"),
+						Resources: resources,
+					},
+				},
+			},
+		})
+
+		pod := synth.Find(t, synth.Manifests(app), "Pod")
+
+		for kind, quantities := range expected[name] {
+			for resource, quantity := range quantities {
+				actual := synth.Field(pod, "spec", "containers", 0, "resources", kind, resource)
+				if actual != quantity {
+					t.Errorf("%s %s %s: expected %s, got %v", name, kind, resource, quantity, actual)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package cdk8skit
+
+import (
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+// KubeServiceOptions describes how a workload is exposed. Type is one of
+// ClusterIP, the default, NodePort, LoadBalancer or None for a headless
+// Service. NodePort fixes the node port of the primary port.
+type KubeServiceOptions struct {
+	Type                          *string
+	NodePort                      *float64
+	LoadBalancerSourceRanges      *[]*string
+	Annotations                   *map[string]*string
+	ExternalTrafficPolicy         *string
+	SessionAffinity               *string
+	SessionAffinityTimeoutSeconds *float64
+	PublishNotReadyAddresses      *bool
+}
+
+func (options *KubeServiceOptions) defaultProps() {
+	if options.Type == nil {
+		options.Type = jsii.String("ClusterIP")
+	}
+}
+
+// Apply sets the options on the metadata and spec of a Service, the spec
+// already having its ports.
+func (options *KubeServiceOptions) Apply(metadata *k8s.ObjectMeta, spec *k8s.ServiceSpec) {
+
+	options.defaultProps()
+
+	if *options.Type == "None" {
+		spec.Type = jsii.String("ClusterIP")
+		spec.ClusterIp = jsii.String("None")
+	} else {
+		spec.Type = options.Type
+	}
+
+	if options.NodePort != nil && spec.Ports != nil && len(*spec.Ports) > 0 {
+		(*spec.Ports)[0].NodePort = options.NodePort
+	}
+
+	spec.LoadBalancerSourceRanges = options.LoadBalancerSourceRanges
+	spec.ExternalTrafficPolicy = options.ExternalTrafficPolicy
+	spec.SessionAffinity = options.SessionAffinity
+	spec.PublishNotReadyAddresses = options.PublishNotReadyAddresses
+
+	if options.SessionAffinityTimeoutSeconds != nil {
+		spec.SessionAffinityConfig = &k8s.SessionAffinityConfig{
+			ClientIp: &k8s.ClientIpConfig{
+				TimeoutSeconds: options.SessionAffinityTimeoutSeconds,
+			},
+		}
+	}
+
+	if options.Annotations != nil {
+		annotations := map[string]*string{}
+		if metadata.Annotations != nil {
+			for key, value := range *metadata.Annotations {
+				annotations[key] = value
+			}
+		}
+		for key, value := range *options.Annotations {
+			annotations[key] = value
+		}
+		metadata.Annotations = &annotations
+	}
+}
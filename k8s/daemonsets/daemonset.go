@@ -0,0 +1,327 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
+)
+
+type KubeDaemonSetResource struct {
+	DaemonSet      k8s.KubeDaemonSet
+	ServiceAccount pods.KubeServiceAccountResource
+}
+
+// KubeHostPathMount mounts a directory or file of the node into the container.
+type KubeHostPathMount struct {
+	Path     *string
+	Type     *string
+	ReadOnly *bool
+}
+
+// KubeDaemonSetUpdate is the rolling update of a DaemonSet, or OnDelete when
+// set: pods are then replaced only after being deleted by hand.
+type KubeDaemonSetUpdate struct {
+	MaxUnavailable k8s.IntOrString
+	MaxSurge       k8s.IntOrString
+	OnDelete       *bool
+}
+
+func (update *KubeDaemonSetUpdate) strategy() *k8s.DaemonSetUpdateStrategy {
+
+	if update.OnDelete != nil && *update.OnDelete {
+		return &k8s.DaemonSetUpdateStrategy{
+			Type: jsii.String("OnDelete"),
+		}
+	}
+
+	maxUnavailable := update.MaxUnavailable
+	if maxUnavailable == nil {
+		maxUnavailable = k8s.IntOrString_FromNumber(jsii.Number(1))
+	}
+
+	return &k8s.DaemonSetUpdateStrategy{
+		Type: jsii.String("RollingUpdate"),
+		RollingUpdate: &k8s.RollingUpdateDaemonSet{
+			MaxUnavailable: maxUnavailable,
+			MaxSurge:       update.MaxSurge,
+		},
+	}
+}
+
+type KubeDaemonSetProps struct {
+	ImagePullPolicy               *string
+	Command                       *[]*string
+	Args                          *[]*string
+	WorkingDir                    *string
+	Ports                         *[]*containers.KubePort
+	Network                       *string
+	Labels                        *recommended.KubeLabels
+	Variables                     *map[string]*string
+	Environment                   *map[string]*k8s.EnvVarSource
+	EnvFrom                       *[]*k8s.EnvFromSource
+	Volumes                       *map[string]*k8s.Volume
+	Mounts                        *map[string]*containers.KubeMount
+	HostPaths                     *map[string]*KubeHostPathMount
+	HostNetwork                   *bool
+	HostPid                       *bool
+	TolerateControlPlane          *bool
+	Update                        *KubeDaemonSetUpdate
+	MinReadySeconds               *float64
+	Resources                     *k8s.ResourceRequirements
+	Liveness                      *k8s.Probe
+	Readiness                     *k8s.Probe
+	PostStart                     *k8s.LifecycleHandler
+	PreStop                       *k8s.LifecycleHandler
+	TerminationGracePeriodSeconds *float64
+	TerminationMessagePolicy      *string
+	InitContainers                *[]*containers.KubeContainerProps
+	Sidecars                      *[]*containers.KubeContainerProps
+	Scheduling                    *pods.KubeSchedulingProps
+	SecurityProfile               *pods.KubeSecurityProfile
+	ServiceAccount                *pods.KubeServiceAccountProps
+}
+
+func (props *KubeDaemonSetProps) defaultProps() {
+	if props.Ports == nil {
+		props.Ports = &[]*containers.KubePort{}
+	}
+	if props.Variables == nil {
+		props.Variables = &map[string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[string]*k8s.Volume{}
+	}
+	if props.HostPaths == nil {
+		props.HostPaths = &map[string]*KubeHostPathMount{}
+	}
+	if props.HostNetwork == nil {
+		props.HostNetwork = jsii.Bool(false)
+	}
+	if props.HostPid == nil {
+		props.HostPid = jsii.Bool(false)
+	}
+	if props.TolerateControlPlane == nil {
+		props.TolerateControlPlane = jsii.Bool(false)
+	}
+	if props.Update == nil {
+		props.Update = &KubeDaemonSetUpdate{}
+	}
+	if props.Resources == nil {
+		props.Resources = &k8s.ResourceRequirements{}
+	}
+}
+
+func hostPathVolumeName(path string) string {
+	return fmt.Sprintf("host-%s", strings.ReplaceAll(strings.Trim(path, "/"), "/", "-"))
+}
+
+func NewKubeDaemonSet(
+	scope constructs.Construct,
+	id string,
+	image string,
+	props *KubeDaemonSetProps,
+) KubeDaemonSetResource {
+
+	props.defaultProps()
+
+	tracker := recommended.KubeTrack(scope, id, jsii.String(image), props.Labels)
+
+	labels := make(map[string]*string)
+
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	variables := containers.KubeEnv(props.Variables, props.Environment)
+
+	mounts := []*k8s.VolumeMount{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volume := (*props.Volumes)[path]
+		mounts = append(mounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      volume.Name,
+		})
+	}
+
+	volumes := []*k8s.Volume{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volumes = append(volumes, (*props.Volumes)[path])
+	}
+
+	for _, path := range containers.KubeSortedKeys(*props.HostPaths) {
+		hostPath := (*props.HostPaths)[path]
+		name := jsii.String(hostPathVolumeName(*hostPath.Path))
+		mounts = append(mounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      name,
+			ReadOnly:  hostPath.ReadOnly,
+		})
+		volumes = append(volumes, &k8s.Volume{
+			Name: name,
+			HostPath: &k8s.HostPathVolumeSource{
+				Path: hostPath.Path,
+				Type: hostPath.Type,
+			},
+		})
+	}
+
+	subMounts, mountVolumes := containers.KubeMounts(props.Mounts)
+
+	mounts = append(mounts, subMounts...)
+
+	initContainers, sidecars, sidecarVolumes := containers.KubeContainers(props.InitContainers, props.Sidecars)
+
+	for _, volume := range append(mountVolumes, sidecarVolumes...) {
+		if !containsVolume(volumes, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	var podInitContainers *[]*k8s.Container
+
+	if len(initContainers) > 0 {
+		podInitContainers = &initContainers
+	}
+
+	var port *float64
+
+	if len(*props.Ports) > 0 {
+		port = (*props.Ports)[0].ContainerPort
+		if port == nil {
+			port = (*props.Ports)[0].Port
+		}
+	}
+
+	podContainers := append([]*k8s.Container{
+		{
+			Name:                     jsii.String(id),
+			Image:                    jsii.String(image),
+			ImagePullPolicy:          props.ImagePullPolicy,
+			Command:                  props.Command,
+			Args:                     props.Args,
+			WorkingDir:               props.WorkingDir,
+			Resources:                props.Resources,
+			Ports:                    containers.KubeContainerPorts(*props.Ports),
+			LivenessProbe:            containers.KubeProbe_WithDefaultPort(props.Liveness, port),
+			ReadinessProbe:           containers.KubeProbe_WithDefaultPort(props.Readiness, port),
+			Lifecycle:                containers.KubeLifecycle(props.PostStart, props.PreStop, port),
+			TerminationMessagePolicy: props.TerminationMessagePolicy,
+			SecurityContext: &k8s.SecurityContext{
+				RunAsNonRoot: jsii.Bool(false),
+			},
+			Env:          &variables,
+			EnvFrom:      props.EnvFrom,
+			VolumeMounts: &mounts,
+		},
+	}, sidecars...)
+
+	podSpec := &k8s.PodSpec{
+		InitContainers: podInitContainers,
+		Containers:     &podContainers,
+		HostNetwork:    props.HostNetwork,
+		HostPid:        props.HostPid,
+		SecurityContext: &k8s.PodSecurityContext{
+			RunAsNonRoot: jsii.Bool(false),
+		},
+		Volumes:                       &volumes,
+		TerminationGracePeriodSeconds: props.TerminationGracePeriodSeconds,
+	}
+
+	var account pods.KubeServiceAccountResource
+
+	if props.ServiceAccount != nil {
+		account = pods.NewKubeServiceAccount(scope, "service-account", props.ServiceAccount)
+		podSpec.ServiceAccountName = account.ServiceAccount.Name()
+		podSpec.AutomountServiceAccountToken = props.ServiceAccount.AutomountToken
+	}
+
+	scheduling := props.Scheduling
+
+	if *props.TolerateControlPlane {
+		merged := pods.KubeSchedulingProps{}
+		if scheduling != nil {
+			merged = *scheduling
+		}
+		tolerations := []*k8s.Toleration{
+			{
+				Key:      jsii.String("node-role.kubernetes.io/control-plane"),
+				Operator: jsii.String("Exists"),
+				Effect:   jsii.String("NoSchedule"),
+			},
+		}
+		if merged.Tolerations != nil {
+			tolerations = append(tolerations, *merged.Tolerations...)
+		}
+		merged.Tolerations = &tolerations
+		scheduling = &merged
+	}
+
+	if scheduling != nil {
+		scheduling.Apply(podSpec, &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(podSpec)
+	}
+
+	daemonset := k8s.NewKubeDaemonSet(
+		scope,
+		jsii.String("daemonset"),
+		&k8s.KubeDaemonSetProps{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &map[string]*string{
+					"io.service": labels["io.service"],
+				},
+			},
+			Spec: &k8s.DaemonSetSpec{
+				MinReadySeconds: props.MinReadySeconds,
+				UpdateStrategy:  props.Update.strategy(),
+				Selector: &k8s.LabelSelector{
+					MatchLabels: &map[string]*string{
+						"io.service": labels["io.service"],
+					},
+				},
+				Template: &k8s.PodTemplateSpec{
+					Metadata: &k8s.ObjectMeta{
+						Labels: &labels,
+					},
+					Spec: podSpec,
+				},
+			},
+		},
+	)
+
+	containers.KubeAttachPullSecrets(daemonset, "/spec/template/spec")
+
+	containers.KubeAttachChecksum(daemonset, "/spec/template/spec")
+
+	tracker.Apply()
+
+	return KubeDaemonSetResource{
+		DaemonSet:      daemonset,
+		ServiceAccount: account,
+	}
+}
+
+func containsVolume(volumes []*k8s.Volume, volume *k8s.Volume) bool {
+	for _, existing := range volumes {
+		if *existing.Name == *volume.Name {
+			return true
+		}
+	}
+	return false
+}
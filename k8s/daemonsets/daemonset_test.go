@@ -0,0 +1,100 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeDaemonSetHostPaths(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeDaemonSet(chart, "collector", "collector:1.0", &KubeDaemonSetProps{
+		HostPaths: &map[string]*KubeHostPathMount{
+			"/host/logs": {
+				Path:     jsii.String("/var/log"),
+				Type:     jsii.String("Directory"),
+				ReadOnly: jsii.Bool(true),
+			},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "DaemonSet"), "spec", "template", "spec")
+
+	if path := synth.Field(spec, "volumes", 0, "hostPath", "path"); path != "/var/log" {
+		t.Errorf("expected the node directory /var/log, got %v", path)
+	}
+	if kind := synth.Field(spec, "volumes", 0, "hostPath", "type"); kind != "Directory" {
+		t.Errorf("expected the hostPath type Directory, got %v", kind)
+	}
+	if name := synth.Field(spec, "containers", 0, "volumeMounts", 0, "name"); name != "host-var-log" {
+		t.Errorf("expected the mount to use the host-var-log volume, got %v", name)
+	}
+	if path := synth.Field(spec, "containers", 0, "volumeMounts", 0, "mountPath"); path != "/host/logs" {
+		t.Errorf("expected the mount at /host/logs, got %v", path)
+	}
+	if readOnly := synth.Field(spec, "containers", 0, "volumeMounts", 0, "readOnly"); readOnly != true {
+		t.Errorf("expected a read-only mount, got %v", readOnly)
+	}
+}
+
+func TestKubeDaemonSetHostNamespaces(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeDaemonSet(chart, "collector", "collector:1.0", &KubeDaemonSetProps{
+		HostNetwork:          jsii.Bool(true),
+		HostPid:              jsii.Bool(true),
+		TolerateControlPlane: jsii.Bool(true),
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "DaemonSet"), "spec", "template", "spec")
+
+	if hostNetwork := synth.Field(spec, "hostNetwork"); hostNetwork != true {
+		t.Errorf("expected hostNetwork, got %v", hostNetwork)
+	}
+	if hostPid := synth.Field(spec, "hostPID"); hostPid != true {
+		t.Errorf("expected hostPID, got %v", hostPid)
+	}
+	if key := synth.Field(spec, "tolerations", 0, "key"); key != "node-role.kubernetes.io/control-plane" {
+		t.Errorf("expected the control plane toleration, got %v", key)
+	}
+}
+
+func TestKubeDaemonSetRollingUpdate(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeDaemonSet(chart, "collector", "collector:1.0", &KubeDaemonSetProps{})
+
+	strategy := synth.Field(synth.Find(t, synth.Manifests(app), "DaemonSet"), "spec", "updateStrategy")
+
+	if kind := synth.Field(strategy, "type"); kind != "RollingUpdate" {
+		t.Errorf("expected RollingUpdate, got %v", kind)
+	}
+	if maxUnavailable := synth.Field(strategy, "rollingUpdate", "maxUnavailable"); maxUnavailable != float64(1) {
+		t.Errorf("expected maxUnavailable 1, got %v", maxUnavailable)
+	}
+}
+
+func TestKubeDaemonSetOnDelete(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeDaemonSet(chart, "collector", "collector:1.0", &KubeDaemonSetProps{
+		Update: &KubeDaemonSetUpdate{
+			OnDelete: jsii.Bool(true),
+		},
+	})
+
+	strategy := synth.Field(synth.Find(t, synth.Manifests(app), "DaemonSet"), "spec", "updateStrategy")
+
+	if kind := synth.Field(strategy, "type"); kind != "OnDelete" {
+		t.Errorf("expected OnDelete, got %v", kind)
+	}
+	if rollingUpdate := synth.Field(strategy, "rollingUpdate"); rollingUpdate != nil {
+		t.Errorf("expected no rolling update, got %v", rollingUpdate)
+	}
+}
@@ -0,0 +1,213 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
+)
+
+type KubeWorkerResource struct {
+	Deployment     k8s.KubeDeployment
+	ServiceAccount pods.KubeServiceAccountResource
+}
+
+type KubeWorkerProps struct {
+	ImagePullPolicy               *string
+	Command                       *[]*string
+	Args                          *[]*string
+	WorkingDir                    *string
+	Replicas                      *float64
+	Network                       *string
+	Labels                        *recommended.KubeLabels
+	Variables                     *map[string]*string
+	Environment                   *map[string]*k8s.EnvVarSource
+	EnvFrom                       *[]*k8s.EnvFromSource
+	Volumes                       *map[string]*k8s.Volume
+	Mounts                        *map[string]*containers.KubeMount
+	Resources                     *k8s.ResourceRequirements
+	Strategy                      *k8s.DeploymentStrategy
+	Liveness                      *k8s.Probe
+	Startup                       *k8s.Probe
+	PostStart                     *k8s.LifecycleHandler
+	PreStop                       *k8s.LifecycleHandler
+	TerminationGracePeriodSeconds *float64
+	TerminationMessagePolicy      *string
+	InitContainers                *[]*containers.KubeContainerProps
+	Sidecars                      *[]*containers.KubeContainerProps
+	Scheduling                    *pods.KubeSchedulingProps
+	SecurityProfile               *pods.KubeSecurityProfile
+	ServiceAccount                *pods.KubeServiceAccountProps
+}
+
+func (props *KubeWorkerProps) defaultProps() {
+	if props.Replicas == nil {
+		props.Replicas = jsii.Number(1)
+	}
+	if props.Variables == nil {
+		props.Variables = &map[string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[string]*k8s.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &k8s.ResourceRequirements{}
+	}
+}
+
+func NewKubeWorker(
+	scope constructs.Construct,
+	id string,
+	image string,
+	props *KubeWorkerProps,
+) KubeWorkerResource {
+
+	props.defaultProps()
+
+	tracker := recommended.KubeTrack(scope, id, jsii.String(image), props.Labels)
+
+	labels := make(map[string]*string)
+
+	labels["io.service"] = jsii.String(id)
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	variables := containers.KubeEnv(props.Variables, props.Environment)
+
+	mounts := []*k8s.VolumeMount{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volume := (*props.Volumes)[path]
+		mounts = append(mounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      volume.Name,
+		})
+	}
+
+	volumes := []*k8s.Volume{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volumes = append(volumes, (*props.Volumes)[path])
+	}
+
+	subMounts, mountVolumes := containers.KubeMounts(props.Mounts)
+
+	mounts = append(mounts, subMounts...)
+
+	initContainers, sidecars, sidecarVolumes := containers.KubeContainers(props.InitContainers, props.Sidecars)
+
+	for _, volume := range append(mountVolumes, sidecarVolumes...) {
+		if !containsVolume(volumes, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	var podInitContainers *[]*k8s.Container
+
+	if len(initContainers) > 0 {
+		podInitContainers = &initContainers
+	}
+
+	podContainers := append([]*k8s.Container{
+		{
+			Name:                     jsii.String(id),
+			Image:                    jsii.String(image),
+			ImagePullPolicy:          props.ImagePullPolicy,
+			Command:                  props.Command,
+			Args:                     props.Args,
+			WorkingDir:               props.WorkingDir,
+			Resources:                props.Resources,
+			Lifecycle:                containers.KubeLifecycle(props.PostStart, props.PreStop, nil),
+			TerminationMessagePolicy: props.TerminationMessagePolicy,
+			LivenessProbe:            containers.KubeProbe_WithDefaultPort(props.Liveness, nil),
+			StartupProbe:             containers.KubeProbe_WithDefaultPort(props.Startup, nil),
+			SecurityContext: &k8s.SecurityContext{
+				RunAsNonRoot: jsii.Bool(false),
+			},
+			Env:          &variables,
+			EnvFrom:      props.EnvFrom,
+			VolumeMounts: &mounts,
+		},
+	}, sidecars...)
+
+	podSpec := &k8s.PodSpec{
+		InitContainers:                podInitContainers,
+		Containers:                    &podContainers,
+		TerminationGracePeriodSeconds: props.TerminationGracePeriodSeconds,
+		SecurityContext: &k8s.PodSecurityContext{
+			RunAsNonRoot: jsii.Bool(false),
+		},
+		Volumes: &volumes,
+	}
+
+	var account pods.KubeServiceAccountResource
+
+	if props.ServiceAccount != nil {
+		account = pods.NewKubeServiceAccount(scope, "service-account", props.ServiceAccount)
+		podSpec.ServiceAccountName = account.ServiceAccount.Name()
+		podSpec.AutomountServiceAccountToken = props.ServiceAccount.AutomountToken
+	}
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(podSpec, &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(podSpec)
+	}
+
+	deployment := k8s.NewKubeDeployment(
+		scope,
+		jsii.String("deployment"),
+		&k8s.KubeDeploymentProps{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &map[string]*string{
+					"io.service": labels["io.service"],
+				},
+			},
+			Spec: &k8s.DeploymentSpec{
+				Replicas: props.Replicas,
+				Strategy: props.Strategy,
+				Selector: &k8s.LabelSelector{
+					MatchLabels: &map[string]*string{
+						"io.service": labels["io.service"],
+					},
+				},
+				Template: &k8s.PodTemplateSpec{
+					Metadata: &k8s.ObjectMeta{
+						Labels: &labels,
+					},
+					Spec: podSpec,
+				},
+			},
+		},
+	)
+
+	containers.KubeAttachPullSecrets(deployment, "/spec/template/spec")
+
+	containers.KubeAttachChecksum(deployment, "/spec/template/spec")
+
+	tracker.Apply()
+
+	return KubeWorkerResource{
+		Deployment:     deployment,
+		ServiceAccount: account,
+	}
+}
+
+func containsVolume(volumes []*k8s.Volume, volume *k8s.Volume) bool {
+	for _, existing := range volumes {
+		if *existing.Name == *volume.Name {
+			return true
+		}
+	}
+	return false
+}
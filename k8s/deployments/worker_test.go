@@ -0,0 +1,151 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+)
+
+func TestKubeWorkerGrpcProbes(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeWorker(chart, "consumer", "consumer:1.0", &KubeWorkerProps{
+		Liveness: containers.KubeProbe_FromGrpc(nil, &containers.KubeProbeOptions{Port: jsii.Number(9000)}),
+	})
+
+	container := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec", "containers", 0)
+
+	if port := synth.Field(container, "livenessProbe", "grpc", "port"); port != float64(9000) {
+		t.Errorf("expected a liveness probe on 9000, got %v", port)
+	}
+}
+
+func TestKubeWorkerGrpcProbeRequiresPort(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	if synth.Panics(func() {
+		NewKubeWorker(chart, "consumer", "consumer:1.0", &KubeWorkerProps{
+			Liveness: containers.KubeProbe_FromGrpc(nil, &containers.KubeProbeOptions{}),
+		})
+	}) == nil {
+		t.Error("expected a panic for a worker gRPC probe without a port")
+	}
+}
+
+func TestKubeWorkerRestrictedProfile(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeWorker(chart, "consumer", "consumer:1.0", &KubeWorkerProps{
+		InitContainers: &[]*containers.KubeContainerProps{
+			{Name: jsii.String("migrate"), Image: jsii.String("consumer:1.0")},
+		},
+		SecurityProfile: &pods.KubeSecurityProfile{},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+
+	if nonRoot := synth.Field(spec, "securityContext", "runAsNonRoot"); nonRoot != true {
+		t.Errorf("expected a non-root pod, got %v", nonRoot)
+	}
+	if seccomp := synth.Field(spec, "securityContext", "seccompProfile", "type"); seccomp != "RuntimeDefault" {
+		t.Errorf("expected the RuntimeDefault seccomp profile, got %v", seccomp)
+	}
+
+	volumes := synth.Field(spec, "volumes").([]interface{})
+	if name := synth.Field(volumes, len(volumes)-1, "name"); name != "writable-tmp" {
+		t.Errorf("expected an emptyDir for /tmp, got %v", name)
+	}
+
+	for _, key := range []string{"containers", "initContainers"} {
+		container := synth.Field(spec, key, 0)
+		if readOnly := synth.Field(container, "securityContext", "readOnlyRootFilesystem"); readOnly != true {
+			t.Errorf("%s: expected a read-only root filesystem, got %v", key, readOnly)
+		}
+		if drop := synth.Field(container, "securityContext", "capabilities", "drop", 0); drop != "ALL" {
+			t.Errorf("%s: expected every capability dropped, got %v", key, drop)
+		}
+		mounts := synth.Field(container, "volumeMounts").([]interface{})
+		if path := synth.Field(mounts, len(mounts)-1, "mountPath"); path != "/tmp" {
+			t.Errorf("%s: expected /tmp mounted, got %v", key, path)
+		}
+	}
+}
+
+func TestKubeWorkerServiceAccount(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	NewKubeWorker(chart, "consumer", "consumer:1.0", &KubeWorkerProps{
+		ServiceAccount: &pods.KubeServiceAccountProps{
+			Rules: &[]*pods.KubeAccessRule{
+				{Resources: &[]*string{jsii.String("configmaps")}, Verbs: &[]*string{jsii.String("get")}},
+			},
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	account := synth.Field(synth.Find(t, manifests, "ServiceAccount"), "metadata", "name")
+	binding := synth.Find(t, manifests, "RoleBinding")
+
+	if name := synth.Field(binding, "roleRef", "name"); name != synth.Field(synth.Find(t, manifests, "Role"), "metadata", "name") {
+		t.Errorf("expected the binding to reference the role, got %v", name)
+	}
+	if name := synth.Field(binding, "subjects", 0, "name"); name != account {
+		t.Errorf("expected the binding to the account %v, got %v", account, name)
+	}
+	if namespace := synth.Field(binding, "subjects", 0, "namespace"); namespace != "apps" {
+		t.Errorf("expected the account namespace, got %v", namespace)
+	}
+
+	spec := synth.Field(synth.Find(t, manifests, "Deployment"), "spec", "template", "spec")
+
+	if name := synth.Field(spec, "serviceAccountName"); name != account {
+		t.Errorf("expected the pods to run as %v, got %v", account, name)
+	}
+	if automount := synth.Field(spec, "automountServiceAccountToken"); automount != true {
+		t.Errorf("expected the token mounted for an account with rules, got %v", automount)
+	}
+}
+
+func TestKubeWorkerLifecycle(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeWorker(chart, "consumer", "consumer:1.0", &KubeWorkerProps{
+		Command:                       &[]*string{jsii.String("/app/consumer")},
+		Args:                          &[]*string{jsii.String("--queue=orders")},
+		WorkingDir:                    jsii.String("/app"),
+		PreStop:                       containers.KubeHandler_FromSleep(jsii.Number(5)),
+		TerminationGracePeriodSeconds: jsii.Number(45),
+		TerminationMessagePolicy:      jsii.String("FallbackToLogsOnError"),
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+	container := synth.Field(spec, "containers", 0)
+
+	if command := synth.Field(container, "command", 0); command != "/app/consumer" {
+		t.Errorf("expected the /app/consumer command, got %v", command)
+	}
+	if arg := synth.Field(container, "args", 0); arg != "--queue=orders" {
+		t.Errorf("expected the --queue=orders argument, got %v", arg)
+	}
+	if workingDir := synth.Field(container, "workingDir"); workingDir != "/app" {
+		t.Errorf("expected the /app working directory, got %v", workingDir)
+	}
+	if seconds := synth.Field(container, "lifecycle", "preStop", "exec", "command", 1); seconds != "5" {
+		t.Errorf("expected a 5 second preStop pause, got %v", seconds)
+	}
+	if policy := synth.Field(container, "terminationMessagePolicy"); policy != "FallbackToLogsOnError" {
+		t.Errorf("expected FallbackToLogsOnError, got %v", policy)
+	}
+	if grace := synth.Field(spec, "terminationGracePeriodSeconds"); grace != float64(45) {
+		t.Errorf("expected a 45 second grace period, got %v", grace)
+	}
+}
@@ -0,0 +1,95 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
+)
+
+type KubeCronJobResource struct {
+	CronJob        k8s.KubeCronJob
+	ServiceAccount pods.KubeServiceAccountResource
+}
+
+// KubeCronJobProps schedules the job described by Job.
+type KubeCronJobProps struct {
+	Schedule                   *string
+	TimeZone                   *string
+	ConcurrencyPolicy          *string
+	StartingDeadlineSeconds    *float64
+	SuccessfulJobsHistoryLimit *float64
+	FailedJobsHistoryLimit     *float64
+	Suspend                    *bool
+	Job                        *KubeJobProps
+}
+
+func (props *KubeCronJobProps) defaultProps() {
+	if props.Schedule == nil {
+		panic("Не указано расписание CronJob")
+	}
+	if props.ConcurrencyPolicy == nil {
+		props.ConcurrencyPolicy = jsii.String("Forbid")
+	}
+	if props.Job == nil {
+		props.Job = &KubeJobProps{}
+	}
+
+	props.Job.defaultProps()
+}
+
+func NewKubeCronJob(
+	scope constructs.Construct,
+	id string,
+	image string,
+	props *KubeCronJobProps,
+) KubeCronJobResource {
+
+	props.defaultProps()
+
+	tracker := recommended.KubeTrack(scope, id, jsii.String(image), props.Job.Labels)
+
+	spec, account := props.Job.jobSpec(scope, id, image, tracker)
+
+	labels := map[string]*string{
+		"io.service": jsii.String(id),
+	}
+
+	cronJob := k8s.NewKubeCronJob(
+		scope,
+		jsii.String("cronjob"),
+		&k8s.KubeCronJobProps{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &labels,
+			},
+			Spec: &k8s.CronJobSpec{
+				Schedule:                   props.Schedule,
+				TimeZone:                   props.TimeZone,
+				ConcurrencyPolicy:          props.ConcurrencyPolicy,
+				StartingDeadlineSeconds:    props.StartingDeadlineSeconds,
+				SuccessfulJobsHistoryLimit: props.SuccessfulJobsHistoryLimit,
+				FailedJobsHistoryLimit:     props.FailedJobsHistoryLimit,
+				Suspend:                    props.Suspend,
+				JobTemplate: &k8s.JobTemplateSpec{
+					Metadata: &k8s.ObjectMeta{
+						Labels: &labels,
+					},
+					Spec: spec,
+				},
+			},
+		},
+	)
+
+	containers.KubeAttachPullSecrets(cronJob, "/spec/jobTemplate/spec/template/spec")
+
+	containers.KubeAttachChecksum(cronJob, "/spec/jobTemplate/spec/template/spec")
+
+	tracker.Apply()
+
+	return KubeCronJobResource{
+		CronJob:        cronJob,
+		ServiceAccount: account,
+	}
+}
@@ -0,0 +1,237 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
+)
+
+type KubeJobResource struct {
+	Job            k8s.KubeJob
+	ServiceAccount pods.KubeServiceAccountResource
+}
+
+// KubeJobProps renders Sidecars as native sidecars, which stop with the job.
+// A regular sidecar never exits and would keep the job from completing.
+type KubeJobProps struct {
+	ImagePullPolicy               *string
+	Command                       *[]*string
+	Args                          *[]*string
+	WorkingDir                    *string
+	Network                       *string
+	Labels                        *recommended.KubeLabels
+	Variables                     *map[string]*string
+	Environment                   *map[string]*k8s.EnvVarSource
+	EnvFrom                       *[]*k8s.EnvFromSource
+	Volumes                       *map[string]*k8s.Volume
+	Mounts                        *map[string]*containers.KubeMount
+	Resources                     *k8s.ResourceRequirements
+	BackoffLimit                  *float64
+	ActiveDeadlineSeconds         *float64
+	TtlSecondsAfterFinished       *float64
+	Parallelism                   *float64
+	Completions                   *float64
+	RestartPolicy                 *string
+	PostStart                     *k8s.LifecycleHandler
+	PreStop                       *k8s.LifecycleHandler
+	TerminationGracePeriodSeconds *float64
+	TerminationMessagePolicy      *string
+	InitContainers                *[]*containers.KubeContainerProps
+	Sidecars                      *[]*containers.KubeContainerProps
+	Scheduling                    *pods.KubeSchedulingProps
+	SecurityProfile               *pods.KubeSecurityProfile
+	ServiceAccount                *pods.KubeServiceAccountProps
+}
+
+func (props *KubeJobProps) defaultProps() {
+	if props.Variables == nil {
+		props.Variables = &map[string]*string{}
+	}
+	if props.Volumes == nil {
+		props.Volumes = &map[string]*k8s.Volume{}
+	}
+	if props.Resources == nil {
+		props.Resources = &k8s.ResourceRequirements{}
+	}
+	if props.RestartPolicy == nil {
+		props.RestartPolicy = jsii.String("Never")
+	}
+	if props.Sidecars != nil {
+		for _, sidecar := range *props.Sidecars {
+			// Обычный сайдкар не завершается, и задание с ним никогда не завершилось бы
+			if sidecar.Native == nil {
+				sidecar.Native = jsii.Bool(true)
+			}
+			if !*sidecar.Native {
+				panic("Сайдкары задания должны быть нативными")
+			}
+		}
+	}
+}
+
+func (props *KubeJobProps) jobSpec(
+	scope constructs.Construct,
+	id string,
+	image string,
+	tracker *recommended.KubeTracker,
+) (*k8s.JobSpec, pods.KubeServiceAccountResource) {
+
+	labels := map[string]*string{
+		"io.service": jsii.String(id),
+	}
+
+	tracker.Merge(labels)
+
+	if props.Network != nil {
+		labels[*props.Network] = jsii.String("true")
+	}
+
+	variables := containers.KubeEnv(props.Variables, props.Environment)
+
+	mounts := []*k8s.VolumeMount{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volume := (*props.Volumes)[path]
+		mounts = append(mounts, &k8s.VolumeMount{
+			MountPath: jsii.String(path),
+			Name:      volume.Name,
+		})
+	}
+
+	volumes := []*k8s.Volume{}
+
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volumes = append(volumes, (*props.Volumes)[path])
+	}
+
+	subMounts, mountVolumes := containers.KubeMounts(props.Mounts)
+
+	mounts = append(mounts, subMounts...)
+
+	initContainers, sidecars, sidecarVolumes := containers.KubeContainers(props.InitContainers, props.Sidecars)
+
+	for _, volume := range append(mountVolumes, sidecarVolumes...) {
+		if !containsVolume(volumes, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	var podInitContainers *[]*k8s.Container
+
+	if len(initContainers) > 0 {
+		podInitContainers = &initContainers
+	}
+
+	podContainers := append([]*k8s.Container{
+		{
+			Name:                     jsii.String(id),
+			Image:                    jsii.String(image),
+			ImagePullPolicy:          props.ImagePullPolicy,
+			Command:                  props.Command,
+			Args:                     props.Args,
+			WorkingDir:               props.WorkingDir,
+			Resources:                props.Resources,
+			Lifecycle:                containers.KubeLifecycle(props.PostStart, props.PreStop, nil),
+			TerminationMessagePolicy: props.TerminationMessagePolicy,
+			SecurityContext: &k8s.SecurityContext{
+				RunAsNonRoot: jsii.Bool(false),
+			},
+			Env:          &variables,
+			EnvFrom:      props.EnvFrom,
+			VolumeMounts: &mounts,
+		},
+	}, sidecars...)
+
+	podSpec := &k8s.PodSpec{
+		InitContainers: podInitContainers,
+		Containers:     &podContainers,
+		RestartPolicy:  props.RestartPolicy,
+		SecurityContext: &k8s.PodSecurityContext{
+			RunAsNonRoot: jsii.Bool(false),
+		},
+		Volumes:                       &volumes,
+		TerminationGracePeriodSeconds: props.TerminationGracePeriodSeconds,
+	}
+
+	var account pods.KubeServiceAccountResource
+
+	if props.ServiceAccount != nil {
+		account = pods.NewKubeServiceAccount(scope, "service-account", props.ServiceAccount)
+		podSpec.ServiceAccountName = account.ServiceAccount.Name()
+		podSpec.AutomountServiceAccountToken = props.ServiceAccount.AutomountToken
+	}
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(podSpec, &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(podSpec)
+	}
+
+	return &k8s.JobSpec{
+		BackoffLimit:            props.BackoffLimit,
+		ActiveDeadlineSeconds:   props.ActiveDeadlineSeconds,
+		TtlSecondsAfterFinished: props.TtlSecondsAfterFinished,
+		Parallelism:             props.Parallelism,
+		Completions:             props.Completions,
+		Template: &k8s.PodTemplateSpec{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &labels,
+			},
+			Spec: podSpec,
+		},
+	}, account
+}
+
+func NewKubeJob(
+	scope constructs.Construct,
+	id string,
+	image string,
+	props *KubeJobProps,
+) KubeJobResource {
+
+	props.defaultProps()
+
+	tracker := recommended.KubeTrack(scope, id, jsii.String(image), props.Labels)
+
+	spec, account := props.jobSpec(scope, id, image, tracker)
+
+	job := k8s.NewKubeJob(
+		scope,
+		jsii.String("job"),
+		&k8s.KubeJobProps{
+			Metadata: &k8s.ObjectMeta{
+				Labels: &map[string]*string{
+					"io.service": jsii.String(id),
+				},
+			},
+			Spec: spec,
+		},
+	)
+
+	containers.KubeAttachPullSecrets(job, "/spec/template/spec")
+
+	containers.KubeAttachChecksum(job, "/spec/template/spec")
+
+	tracker.Apply()
+
+	return KubeJobResource{
+		Job:            job,
+		ServiceAccount: account,
+	}
+}
+
+func containsVolume(volumes []*k8s.Volume, volume *k8s.Volume) bool {
+	for _, existing := range volumes {
+		if *existing.Name == *volume.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+)
+
+func TestKubeCronJobNetwork(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeCronJob(chart, "report", "report:1.0", &KubeCronJobProps{
+		Schedule: jsii.String("0 0 * * *"),
+		Job: &KubeJobProps{
+			Network: jsii.String("io.network/backend"),
+		},
+	})
+
+	cronJob := synth.Find(t, synth.Manifests(app), "CronJob")
+
+	if label := synth.Field(cronJob, "spec", "jobTemplate", "spec", "template", "metadata", "labels", "io.network/backend"); label != "true" {
+		t.Errorf("expected the pod to join the network, got %v", label)
+	}
+}
+
+func TestKubeJobNativeSidecars(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeJob(chart, "migrate", "migrate:1.0", &KubeJobProps{
+		Sidecars: &[]*containers.KubeContainerProps{
+			{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30")},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "Job"), "spec", "template", "spec")
+
+	if count := len(synth.Field(spec, "containers").([]interface{})); count != 1 {
+		t.Errorf("expected the sidecar out of the containers, got %d containers", count)
+	}
+	if name := synth.Field(spec, "initContainers", 0, "name"); name != "proxy" {
+		t.Errorf("expected the proxy sidecar as an init container, got %v", name)
+	}
+	if policy := synth.Field(spec, "initContainers", 0, "restartPolicy"); policy != "Always" {
+		t.Errorf("expected a native sidecar, got restart policy %v", policy)
+	}
+}
+
+func TestKubeCronJobRejectsRegularSidecars(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	if synth.Panics(func() {
+		NewKubeCronJob(chart, "report", "report:1.0", &KubeCronJobProps{
+			Schedule: jsii.String("0 0 * * *"),
+			Job: &KubeJobProps{
+				Sidecars: &[]*containers.KubeContainerProps{
+					{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30"), Native: jsii.Bool(false)},
+				},
+			},
+		})
+	}) == nil {
+		t.Error("expected a panic for a regular job sidecar")
+	}
+}
@@ -0,0 +1,144 @@
+package cdk8skit
+
+import (
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+)
+
+type KubeRelabelConfig struct {
+	SourceLabels *[]*string
+	Separator    *string
+	TargetLabel  *string
+	Regex        *string
+	Replacement  *string
+	Action       *string
+}
+
+func (config *KubeRelabelConfig) render() map[string]interface{} {
+
+	relabeling := map[string]interface{}{}
+
+	if config.SourceLabels != nil {
+		relabeling["sourceLabels"] = config.SourceLabels
+	}
+	if config.Separator != nil {
+		relabeling["separator"] = config.Separator
+	}
+	if config.TargetLabel != nil {
+		relabeling["targetLabel"] = config.TargetLabel
+	}
+	if config.Regex != nil {
+		relabeling["regex"] = config.Regex
+	}
+	if config.Replacement != nil {
+		relabeling["replacement"] = config.Replacement
+	}
+	if config.Action != nil {
+		relabeling["action"] = config.Action
+	}
+
+	return relabeling
+}
+
+// KubeMetricsProps describes how Prometheus Operator scrapes a workload. Port is
+// the Service port name, or the container port name for a PodMonitor. Labels
+// are set on the monitor itself, for the Prometheus monitor selector.
+type KubeMetricsProps struct {
+	Port          *string
+	Path          *string
+	Interval      *string
+	ScrapeTimeout *string
+	Relabelings   *[]*KubeRelabelConfig
+	PodMonitor    *bool
+	Labels        *map[string]*string
+}
+
+func (props *KubeMetricsProps) defaultProps() {
+	if props.Path == nil {
+		props.Path = jsii.String("/metrics")
+	}
+	if props.PodMonitor == nil {
+		props.PodMonitor = jsii.Bool(false)
+	}
+}
+
+func (props *KubeMetricsProps) endpoint(port *string, targetPort *float64) map[string]interface{} {
+
+	endpoint := map[string]interface{}{
+		"path": props.Path,
+	}
+
+	if port != nil {
+		endpoint["port"] = port
+	} else {
+		endpoint["targetPort"] = targetPort
+	}
+	if props.Interval != nil {
+		endpoint["interval"] = props.Interval
+	}
+	if props.ScrapeTimeout != nil {
+		endpoint["scrapeTimeout"] = props.ScrapeTimeout
+	}
+	if props.Relabelings != nil {
+		relabelings := []interface{}{}
+		for _, relabeling := range *props.Relabelings {
+			relabelings = append(relabelings, relabeling.render())
+		}
+		endpoint["relabelings"] = relabelings
+	}
+
+	return endpoint
+}
+
+// NewKubeMonitor renders a ServiceMonitor selecting the Services with the given
+// labels, or a PodMonitor selecting the pods when PodMonitor is set. Without
+// a named port the PodMonitor scrapes the given port by its container number.
+func NewKubeMonitor(
+	scope constructs.Construct,
+	selector *map[string]*string,
+	port *containers.KubePort,
+	props *KubeMetricsProps,
+) cdk8s.ApiObject {
+
+	props.defaultProps()
+
+	kind := "ServiceMonitor"
+	id := "service-monitor"
+	endpoints := "endpoints"
+	name := port.ServiceName()
+
+	if *props.PodMonitor {
+		kind = "PodMonitor"
+		id = "pod-monitor"
+		endpoints = "podMetricsEndpoints"
+		name = port.Name
+	}
+
+	if props.Port != nil {
+		name = props.Port
+	}
+
+	monitor := cdk8s.NewApiObject(scope, jsii.String(id), &cdk8s.ApiObjectProps{
+		ApiVersion: jsii.String("monitoring.coreos.com/v1"),
+		Kind:       jsii.String(kind),
+		Metadata: &cdk8s.ApiObjectMetadata{
+			Labels: props.Labels,
+		},
+	})
+
+	monitor.AddJsonPatch(cdk8s.JsonPatch_Add(
+		jsii.String("/spec"),
+		map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": selector,
+			},
+			endpoints: []interface{}{
+				props.endpoint(name, port.ContainerPort),
+			},
+		},
+	))
+
+	return monitor
+}
@@ -0,0 +1,102 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+)
+
+func TestKubeServiceMonitor(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	NewKubeMonitor(chart, &map[string]*string{"io.service": jsii.String("api")}, &containers.KubePort{
+		Port:          jsii.Number(80),
+		ContainerPort: jsii.Number(8080),
+	}, &KubeMetricsProps{
+		Interval: jsii.String("30s"),
+		Labels:   &map[string]*string{"release": jsii.String("prometheus")},
+		Relabelings: &[]*KubeRelabelConfig{
+			{SourceLabels: &[]*string{jsii.String("__meta_kubernetes_pod_name")}, TargetLabel: jsii.String("pod")},
+		},
+	})
+
+	monitor := synth.Find(t, synth.Manifests(app), "ServiceMonitor")
+
+	if version := monitor["apiVersion"]; version != "monitoring.coreos.com/v1" {
+		t.Errorf("expected the Prometheus Operator API, got %v", version)
+	}
+	if label := synth.Field(monitor, "metadata", "labels", "release"); label != "prometheus" {
+		t.Errorf("expected the monitor label, got %v", label)
+	}
+	if service := synth.Field(monitor, "spec", "selector", "matchLabels", "io.service"); service != "api" {
+		t.Errorf("expected the Service selector, got %v", service)
+	}
+
+	endpoint := synth.Field(monitor, "spec", "endpoints", 0)
+
+	expected := map[string]interface{}{
+		"port":     "80",
+		"path":     "/metrics",
+		"interval": "30s",
+	}
+
+	for key, value := range expected {
+		if actual := synth.Field(endpoint, key); actual != value {
+			t.Errorf("%s: expected %v, got %v", key, value, actual)
+		}
+	}
+
+	if label := synth.Field(endpoint, "relabelings", 0, "targetLabel"); label != "pod" {
+		t.Errorf("expected the relabeling, got %v", label)
+	}
+}
+
+func TestKubePodMonitor(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	NewKubeMonitor(chart, &map[string]*string{"io.service": jsii.String("api")}, &containers.KubePort{
+		Port:          jsii.Number(80),
+		ContainerPort: jsii.Number(8080),
+	}, &KubeMetricsProps{
+		PodMonitor: jsii.Bool(true),
+		Path:       jsii.String("/stats"),
+	})
+
+	monitor := synth.Find(t, synth.Manifests(app), "PodMonitor")
+
+	endpoint := synth.Field(monitor, "spec", "podMetricsEndpoints", 0)
+
+	if port := synth.Field(endpoint, "targetPort"); port != float64(8080) {
+		t.Errorf("expected the unnamed container port 8080, got %v", port)
+	}
+	if port := synth.Field(endpoint, "port"); port != nil {
+		t.Errorf("expected no port name, got %v", port)
+	}
+	if path := synth.Field(endpoint, "path"); path != "/stats" {
+		t.Errorf("expected the /stats path, got %v", path)
+	}
+}
+
+func TestKubePodMonitorNamedPort(t *testing.T) {
+
+	app, chart := synth.NewChart("apps")
+
+	NewKubeMonitor(chart, &map[string]*string{"io.service": jsii.String("api")}, &containers.KubePort{
+		Name:          jsii.String("http"),
+		Port:          jsii.Number(80),
+		ContainerPort: jsii.Number(8080),
+	}, &KubeMetricsProps{
+		PodMonitor: jsii.Bool(true),
+		Port:       jsii.String("metrics"),
+	})
+
+	monitor := synth.Find(t, synth.Manifests(app), "PodMonitor")
+
+	if port := synth.Field(monitor, "spec", "podMetricsEndpoints", 0, "port"); port != "metrics" {
+		t.Errorf("expected the metrics port, got %v", port)
+	}
+}
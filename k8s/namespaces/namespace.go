@@ -0,0 +1,233 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type KubeNamespaceResource struct {
+	Chart         cdk8s.Chart
+	Namespace     k8s.KubeNamespace
+	Quota         k8s.KubeResourceQuota
+	LimitRange    k8s.KubeLimitRange
+	NetworkPolicy k8s.KubeNetworkPolicy
+	scope         constructs.Construct
+}
+
+// KubeNamespaceQuota caps the total of the namespace with Kubernetes
+// quantities, e.g. "4" for CPU or "8Gi" for memory.
+type KubeNamespaceQuota struct {
+	CpuRequests    *string
+	CpuLimits      *string
+	MemoryRequests *string
+	MemoryLimits   *string
+	Storage        *string
+	Pods           *string
+	Claims         *string
+}
+
+func (quota *KubeNamespaceQuota) defaultProps() {
+	if quota.CpuRequests == nil {
+		quota.CpuRequests = jsii.String("4")
+	}
+	if quota.CpuLimits == nil {
+		quota.CpuLimits = jsii.String("8")
+	}
+	if quota.MemoryRequests == nil {
+		quota.MemoryRequests = jsii.String("8Gi")
+	}
+	if quota.MemoryLimits == nil {
+		quota.MemoryLimits = jsii.String("16Gi")
+	}
+	if quota.Storage == nil {
+		quota.Storage = jsii.String("100Gi")
+	}
+	if quota.Pods == nil {
+		quota.Pods = jsii.String("50")
+	}
+	if quota.Claims == nil {
+		quota.Claims = jsii.String("20")
+	}
+}
+
+// KubeNamespaceLimits are the requests and limits given to containers that
+// set none.
+type KubeNamespaceLimits struct {
+	CpuRequest    *string
+	CpuLimit      *string
+	MemoryRequest *string
+	MemoryLimit   *string
+}
+
+func (limits *KubeNamespaceLimits) defaultProps() {
+	if limits.CpuRequest == nil {
+		limits.CpuRequest = jsii.String("100m")
+	}
+	if limits.CpuLimit == nil {
+		limits.CpuLimit = jsii.String("500m")
+	}
+	if limits.MemoryRequest == nil {
+		limits.MemoryRequest = jsii.String("128Mi")
+	}
+	if limits.MemoryLimit == nil {
+		limits.MemoryLimit = jsii.String("512Mi")
+	}
+}
+
+// KubeNamespaceProps describes a namespace. SecurityLevel, one of privileged,
+// baseline or restricted, is enforced by Pod Security admission, baseline by
+// default; AuditLevel, restricted by default, only warns and audits. DenyAll
+// adds a policy rejecting any ingress traffic not allowed by another policy.
+type KubeNamespaceProps struct {
+	Name          *string
+	Labels        *map[string]*string
+	SecurityLevel *string
+	AuditLevel    *string
+	Quota         *KubeNamespaceQuota
+	Limits        *KubeNamespaceLimits
+	DenyAll       *bool
+}
+
+func (props *KubeNamespaceProps) defaultProps(id string) {
+	if props.Name == nil {
+		props.Name = jsii.String(id)
+	}
+	if props.Labels == nil {
+		props.Labels = &map[string]*string{}
+	}
+	if props.SecurityLevel == nil {
+		props.SecurityLevel = jsii.String("baseline")
+	}
+	if props.AuditLevel == nil {
+		props.AuditLevel = jsii.String("restricted")
+	}
+	if props.Quota == nil {
+		props.Quota = &KubeNamespaceQuota{}
+	}
+	if props.Limits == nil {
+		props.Limits = &KubeNamespaceLimits{}
+	}
+	if props.DenyAll == nil {
+		props.DenyAll = jsii.Bool(false)
+	}
+
+	for _, level := range []*string{props.SecurityLevel, props.AuditLevel} {
+		switch *level {
+		case "privileged", "baseline", "restricted":
+		default:
+			panic(fmt.Sprintf("Неизвестный уровень безопасности: %s", *level))
+		}
+	}
+
+	props.Quota.defaultProps()
+	props.Limits.defaultProps()
+}
+
+func (props *KubeNamespaceProps) labels() *map[string]*string {
+
+	labels := map[string]*string{}
+
+	for key, value := range *props.Labels {
+		labels[key] = value
+	}
+
+	labels["pod-security.kubernetes.io/enforce"] = props.SecurityLevel
+	labels["pod-security.kubernetes.io/enforce-version"] = jsii.String("latest")
+	labels["pod-security.kubernetes.io/audit"] = props.AuditLevel
+	labels["pod-security.kubernetes.io/warn"] = props.AuditLevel
+
+	return &labels
+}
+
+// NewKubeNamespace creates the namespace in a chart of its own. Kit
+// constructs created in this chart, or in charts returned by NewChart, land
+// in the namespace.
+func NewKubeNamespace(scope constructs.Construct, id string, props *KubeNamespaceProps) KubeNamespaceResource {
+
+	props.defaultProps(id)
+
+	chart := cdk8s.NewChart(scope, jsii.String(id), &cdk8s.ChartProps{
+		Namespace: props.Name,
+	})
+
+	KubeKeepClusterScoped(chart)
+
+	namespace := k8s.NewKubeNamespace(chart, jsii.String("namespace"), &k8s.KubeNamespaceProps{
+		Metadata: &k8s.ObjectMeta{
+			Name:   props.Name,
+			Labels: props.labels(),
+		},
+	})
+
+	quota := k8s.NewKubeResourceQuota(chart, jsii.String("quota"), &k8s.KubeResourceQuotaProps{
+		Spec: &k8s.ResourceQuotaSpec{
+			Hard: &map[string]k8s.Quantity{
+				"requests.cpu":           k8s.Quantity_FromString(props.Quota.CpuRequests),
+				"limits.cpu":             k8s.Quantity_FromString(props.Quota.CpuLimits),
+				"requests.memory":        k8s.Quantity_FromString(props.Quota.MemoryRequests),
+				"limits.memory":          k8s.Quantity_FromString(props.Quota.MemoryLimits),
+				"requests.storage":       k8s.Quantity_FromString(props.Quota.Storage),
+				"pods":                   k8s.Quantity_FromString(props.Quota.Pods),
+				"persistentvolumeclaims": k8s.Quantity_FromString(props.Quota.Claims),
+			},
+		},
+	})
+
+	limitRange := k8s.NewKubeLimitRange(chart, jsii.String("limit-range"), &k8s.KubeLimitRangeProps{
+		Spec: &k8s.LimitRangeSpec{
+			Limits: &[]*k8s.LimitRangeItem{
+				{
+					Type: jsii.String("Container"),
+					DefaultRequest: &map[string]k8s.Quantity{
+						"cpu":    k8s.Quantity_FromString(props.Limits.CpuRequest),
+						"memory": k8s.Quantity_FromString(props.Limits.MemoryRequest),
+					},
+					Default: &map[string]k8s.Quantity{
+						"cpu":    k8s.Quantity_FromString(props.Limits.CpuLimit),
+						"memory": k8s.Quantity_FromString(props.Limits.MemoryLimit),
+					},
+				},
+			},
+		},
+	})
+
+	var networkPolicy k8s.KubeNetworkPolicy
+
+	if *props.DenyAll {
+		networkPolicy = k8s.NewKubeNetworkPolicy(chart, jsii.String("deny-all"), &k8s.KubeNetworkPolicyProps{
+			Spec: &k8s.NetworkPolicySpec{
+				PodSelector: &k8s.LabelSelector{},
+				PolicyTypes: &[]*string{jsii.String("Ingress")},
+			},
+		})
+	}
+
+	return KubeNamespaceResource{
+		Chart:         chart,
+		Namespace:     namespace,
+		Quota:         quota,
+		LimitRange:    limitRange,
+		NetworkPolicy: networkPolicy,
+		scope:         scope,
+	}
+}
+
+// NewChart creates a chart in the namespace, for a kit construct that needs a
+// chart of its own. The chart is a sibling of the namespace chart rendered
+// after it, so the namespace exists before the objects placed into it.
+func (resource KubeNamespaceResource) NewChart(id string) cdk8s.Chart {
+
+	chart := cdk8s.NewChart(resource.scope, jsii.String(id), &cdk8s.ChartProps{
+		Namespace: resource.Namespace.Name(),
+	})
+
+	chart.AddDependency(resource.Chart)
+
+	KubeKeepClusterScoped(chart)
+
+	return chart
+}
@@ -0,0 +1,39 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeNamespaceClusterScoped(t *testing.T) {
+
+	app := cdk8s.NewApp(nil)
+
+	namespace := NewKubeNamespace(app, "team", &KubeNamespaceProps{})
+
+	chart := namespace.NewChart("apps")
+
+	k8s.NewKubeClusterRole(chart, jsii.String("reader"), &k8s.KubeClusterRoleProps{})
+	k8s.NewKubeConfigMap(chart, jsii.String("config"), &k8s.KubeConfigMapProps{})
+
+	// Повторный синтез не должен применять патч удаления ещё раз
+	app.SynthYaml()
+
+	manifests := synth.Manifests(app)
+
+	for _, kind := range []string{"Namespace", "ClusterRole"} {
+		if ns := synth.Field(synth.Find(t, manifests, kind), "metadata", "namespace"); ns != nil {
+			t.Errorf("%s: expected no namespace, got %v", kind, ns)
+		}
+	}
+
+	for _, kind := range []string{"ResourceQuota", "LimitRange", "ConfigMap"} {
+		if ns := synth.Field(synth.Find(t, manifests, kind), "metadata", "namespace"); ns != "team" {
+			t.Errorf("%s: expected the team namespace, got %v", kind, ns)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package cdk8skit
+
+import (
+	"fmt"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type KubeAntiAffinity string
+
+const (
+	// Replicas of the same service never share a node.
+	KubeAntiAffinity_REQUIRED KubeAntiAffinity = "REQUIRED"
+	// Replicas of the same service avoid sharing a node when possible.
+	KubeAntiAffinity_PREFERRED KubeAntiAffinity = "PREFERRED"
+)
+
+// KubeSchedulingProps controls where the pods of a workload land. AntiAffinity
+// and SpreadReplicas select the replicas of the same io.service; TopologySpread
+// constraints are added as given.
+type KubeSchedulingProps struct {
+	NodeSelector          *map[string]*string
+	Tolerations           *[]*k8s.Toleration
+	PriorityClassName     *string
+	RequiredNodeAffinity  *[]*k8s.NodeSelectorTerm
+	PreferredNodeAffinity *[]*k8s.PreferredSchedulingTerm
+	AntiAffinity          KubeAntiAffinity
+	SpreadReplicas        *bool
+	TopologySpread        *[]*k8s.TopologySpreadConstraint
+}
+
+func (props *KubeSchedulingProps) affinity(selector *map[string]*string) *k8s.Affinity {
+
+	var affinity *k8s.Affinity
+
+	if props.RequiredNodeAffinity != nil || props.PreferredNodeAffinity != nil {
+		affinity = &k8s.Affinity{
+			NodeAffinity: &k8s.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: props.PreferredNodeAffinity,
+			},
+		}
+		if props.RequiredNodeAffinity != nil {
+			affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8s.NodeSelector{
+				NodeSelectorTerms: props.RequiredNodeAffinity,
+			}
+		}
+	}
+
+	if props.AntiAffinity != "" {
+		if affinity == nil {
+			affinity = &k8s.Affinity{}
+		}
+		term := &k8s.PodAffinityTerm{
+			TopologyKey: jsii.String("kubernetes.io/hostname"),
+			LabelSelector: &k8s.LabelSelector{
+				MatchLabels: selector,
+			},
+		}
+		switch props.AntiAffinity {
+		case KubeAntiAffinity_REQUIRED:
+			affinity.PodAntiAffinity = &k8s.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &[]*k8s.PodAffinityTerm{term},
+			}
+		case KubeAntiAffinity_PREFERRED:
+			affinity.PodAntiAffinity = &k8s.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: &[]*k8s.WeightedPodAffinityTerm{
+					{
+						Weight:          jsii.Number(100),
+						PodAffinityTerm: term,
+					},
+				},
+			}
+		default:
+			panic(fmt.Sprintf("Неизвестный режим anti-affinity: %s", props.AntiAffinity))
+		}
+	}
+
+	return affinity
+}
+
+func (props *KubeSchedulingProps) topologySpread(selector *map[string]*string) []*k8s.TopologySpreadConstraint {
+
+	constraints := []*k8s.TopologySpreadConstraint{}
+
+	if props.SpreadReplicas != nil && *props.SpreadReplicas {
+		for _, topologyKey := range []string{"topology.kubernetes.io/zone", "kubernetes.io/hostname"} {
+			constraints = append(constraints, &k8s.TopologySpreadConstraint{
+				MaxSkew:           jsii.Number(1),
+				TopologyKey:       jsii.String(topologyKey),
+				WhenUnsatisfiable: jsii.String("ScheduleAnyway"),
+				LabelSelector: &k8s.LabelSelector{
+					MatchLabels: selector,
+				},
+			})
+		}
+	}
+
+	if props.TopologySpread != nil {
+		constraints = append(constraints, *props.TopologySpread...)
+	}
+
+	return constraints
+}
+
+// Apply sets the scheduling fields of the pod spec.
+// The selector identifies the replicas of the workload.
+func (props *KubeSchedulingProps) Apply(spec *k8s.PodSpec, selector *map[string]*string) {
+
+	spec.NodeSelector = props.NodeSelector
+	spec.Tolerations = props.Tolerations
+	spec.PriorityClassName = props.PriorityClassName
+	spec.Affinity = props.affinity(selector)
+
+	if constraints := props.topologySpread(selector); len(constraints) > 0 {
+		spec.TopologySpreadConstraints = &constraints
+	}
+}
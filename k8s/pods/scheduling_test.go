@@ -0,0 +1,115 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+// renderKubeScheduling renders a deployment scheduled with the props and
+// returns its pod spec.
+func renderKubeScheduling(t *testing.T, props *KubeSchedulingProps) interface{} {
+
+	app, chart := synth.NewChart("")
+
+	selector := &map[string]*string{"io.service": jsii.String("api")}
+
+	spec := &k8s.PodSpec{
+		Containers: &[]*k8s.Container{
+			{Name: jsii.String("api"), Image: jsii.String("nginx")},
+		},
+	}
+
+	props.Apply(spec, selector)
+
+	k8s.NewKubeDeployment(chart, jsii.String("deployment"), &k8s.KubeDeploymentProps{
+		Spec: &k8s.DeploymentSpec{
+			Selector: &k8s.LabelSelector{MatchLabels: selector},
+			Template: &k8s.PodTemplateSpec{
+				Metadata: &k8s.ObjectMeta{Labels: selector},
+				Spec:     spec,
+			},
+		},
+	})
+
+	return synth.Field(synth.Find(t, synth.Manifests(app), "Deployment"), "spec", "template", "spec")
+}
+
+func TestKubeSchedulingNodes(t *testing.T) {
+
+	spec := renderKubeScheduling(t, &KubeSchedulingProps{
+		NodeSelector:      &map[string]*string{"node.example.com/pool": jsii.String("db")},
+		PriorityClassName: jsii.String("critical"),
+		Tolerations: &[]*k8s.Toleration{
+			{Key: jsii.String("dedicated"), Operator: jsii.String("Equal"), Value: jsii.String("db"), Effect: jsii.String("NoSchedule")},
+		},
+		RequiredNodeAffinity: &[]*k8s.NodeSelectorTerm{
+			{
+				MatchExpressions: &[]*k8s.NodeSelectorRequirement{
+					{Key: jsii.String("kubernetes.io/arch"), Operator: jsii.String("In"), Values: &[]*string{jsii.String("amd64")}},
+				},
+			},
+		},
+	})
+
+	if pool := synth.Field(spec, "nodeSelector", "node.example.com/pool"); pool != "db" {
+		t.Errorf("expected the db node pool, got %v", pool)
+	}
+	if priority := synth.Field(spec, "priorityClassName"); priority != "critical" {
+		t.Errorf("expected the critical priority class, got %v", priority)
+	}
+	if effect := synth.Field(spec, "tolerations", 0, "effect"); effect != "NoSchedule" {
+		t.Errorf("expected the NoSchedule toleration, got %v", effect)
+	}
+
+	term := synth.Field(spec, "affinity", "nodeAffinity", "requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms", 0)
+
+	if key := synth.Field(term, "matchExpressions", 0, "key"); key != "kubernetes.io/arch" {
+		t.Errorf("expected the required node affinity, got %v", term)
+	}
+}
+
+func TestKubeSchedulingAntiAffinity(t *testing.T) {
+
+	required := renderKubeScheduling(t, &KubeSchedulingProps{AntiAffinity: KubeAntiAffinity_REQUIRED})
+
+	term := synth.Field(required, "affinity", "podAntiAffinity", "requiredDuringSchedulingIgnoredDuringExecution", 0)
+
+	if key := synth.Field(term, "topologyKey"); key != "kubernetes.io/hostname" {
+		t.Errorf("expected replicas spread across nodes, got %v", key)
+	}
+	if service := synth.Field(term, "labelSelector", "matchLabels", "io.service"); service != "api" {
+		t.Errorf("expected the replicas of the service, got %v", service)
+	}
+
+	preferred := renderKubeScheduling(t, &KubeSchedulingProps{AntiAffinity: KubeAntiAffinity_PREFERRED})
+
+	weighted := synth.Field(preferred, "affinity", "podAntiAffinity", "preferredDuringSchedulingIgnoredDuringExecution", 0)
+
+	if weight := synth.Field(weighted, "weight"); weight != float64(100) {
+		t.Errorf("expected a weight of 100, got %v", weight)
+	}
+	if service := synth.Field(weighted, "podAffinityTerm", "labelSelector", "matchLabels", "io.service"); service != "api" {
+		t.Errorf("expected the replicas of the service, got %v", service)
+	}
+}
+
+func TestKubeSchedulingSpreadReplicas(t *testing.T) {
+
+	spec := renderKubeScheduling(t, &KubeSchedulingProps{SpreadReplicas: jsii.Bool(true)})
+
+	for i, key := range []string{"topology.kubernetes.io/zone", "kubernetes.io/hostname"} {
+		constraint := synth.Field(spec, "topologySpreadConstraints", i)
+		if topologyKey := synth.Field(constraint, "topologyKey"); topologyKey != key {
+			t.Errorf("constraint %d: expected %s, got %v", i, key, topologyKey)
+		}
+		if skew := synth.Field(constraint, "maxSkew"); skew != float64(1) {
+			t.Errorf("constraint %d: expected a skew of 1, got %v", i, skew)
+		}
+		if service := synth.Field(constraint, "labelSelector", "matchLabels", "io.service"); service != "api" {
+			t.Errorf("constraint %d: expected the replicas of the service, got %v", i, service)
+		}
+	}
+}
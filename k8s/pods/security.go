@@ -0,0 +1,182 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+)
+
+type KubeSecurityLevel string
+
+const (
+	// The insecure context the constructs render without a profile.
+	KubeSecurityLevel_PRIVILEGED KubeSecurityLevel = "PRIVILEGED"
+	// Pod Security Standard baseline: no privileged containers, RuntimeDefault seccomp.
+	KubeSecurityLevel_BASELINE KubeSecurityLevel = "BASELINE"
+	// Pod Security Standard restricted: non-root, read-only root filesystem, no capabilities.
+	KubeSecurityLevel_RESTRICTED KubeSecurityLevel = "RESTRICTED"
+)
+
+// KubeSecurityProfile sets the security context of the pod and all its
+// containers. Fields left nil take the value of the Level, RESTRICTED by
+// default. WritablePaths get emptyDir volumes mounted into every container,
+// so they stay writable with a read-only root filesystem.
+type KubeSecurityProfile struct {
+	Level                    KubeSecurityLevel
+	RunAsNonRoot             *bool
+	User                     *float64
+	Group                    *float64
+	FsGroup                  *float64
+	ReadOnlyRootFilesystem   *bool
+	AllowPrivilegeEscalation *bool
+	Privileged               *bool
+	DropCapabilities         *[]*string
+	AddCapabilities          *[]*string
+	SeccompProfile           *string
+	WritablePaths            *[]*string
+}
+
+func (props *KubeSecurityProfile) defaultProps() {
+	if props.Level == "" {
+		props.Level = KubeSecurityLevel_RESTRICTED
+	}
+	if props.AllowPrivilegeEscalation == nil {
+		props.AllowPrivilegeEscalation = jsii.Bool(false)
+	}
+	if props.Privileged == nil {
+		props.Privileged = jsii.Bool(false)
+	}
+
+	switch props.Level {
+	case KubeSecurityLevel_PRIVILEGED:
+	case KubeSecurityLevel_BASELINE:
+		if props.SeccompProfile == nil {
+			props.SeccompProfile = jsii.String("RuntimeDefault")
+		}
+	case KubeSecurityLevel_RESTRICTED:
+		if props.RunAsNonRoot == nil {
+			props.RunAsNonRoot = jsii.Bool(true)
+		}
+		if props.User == nil {
+			props.User = jsii.Number(1000)
+		}
+		if props.Group == nil {
+			props.Group = jsii.Number(1000)
+		}
+		if props.FsGroup == nil {
+			props.FsGroup = jsii.Number(1000)
+		}
+		if props.ReadOnlyRootFilesystem == nil {
+			props.ReadOnlyRootFilesystem = jsii.Bool(true)
+		}
+		if props.DropCapabilities == nil {
+			props.DropCapabilities = &[]*string{jsii.String("ALL")}
+		}
+		if props.SeccompProfile == nil {
+			props.SeccompProfile = jsii.String("RuntimeDefault")
+		}
+		if props.WritablePaths == nil {
+			props.WritablePaths = &[]*string{jsii.String("/tmp")}
+		}
+	default:
+		panic(fmt.Sprintf("Неизвестный уровень безопасности: %s", props.Level))
+	}
+
+	if props.RunAsNonRoot == nil {
+		props.RunAsNonRoot = jsii.Bool(false)
+	}
+	if props.ReadOnlyRootFilesystem == nil {
+		props.ReadOnlyRootFilesystem = jsii.Bool(false)
+	}
+	if props.WritablePaths == nil {
+		props.WritablePaths = &[]*string{}
+	}
+}
+
+func (props *KubeSecurityProfile) podContext() *k8s.PodSecurityContext {
+
+	context := &k8s.PodSecurityContext{
+		RunAsNonRoot: props.RunAsNonRoot,
+		RunAsUser:    props.User,
+		RunAsGroup:   props.Group,
+		FsGroup:      props.FsGroup,
+	}
+
+	if props.SeccompProfile != nil {
+		context.SeccompProfile = &k8s.SeccompProfile{Type: props.SeccompProfile}
+	}
+
+	return context
+}
+
+func (props *KubeSecurityProfile) containerContext() *k8s.SecurityContext {
+
+	context := &k8s.SecurityContext{
+		RunAsNonRoot:             props.RunAsNonRoot,
+		RunAsUser:                props.User,
+		RunAsGroup:               props.Group,
+		ReadOnlyRootFilesystem:   props.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: props.AllowPrivilegeEscalation,
+		Privileged:               props.Privileged,
+	}
+
+	if props.DropCapabilities != nil || props.AddCapabilities != nil {
+		context.Capabilities = &k8s.Capabilities{
+			Drop: props.DropCapabilities,
+			Add:  props.AddCapabilities,
+		}
+	}
+
+	return context
+}
+
+func writableVolumeName(path string) string {
+	return fmt.Sprintf("writable-%s", strings.ReplaceAll(strings.Trim(path, "/"), "/", "-"))
+}
+
+// Apply replaces the security contexts of the pod spec and of all its
+// containers, so it must run after every container has been added.
+func (props *KubeSecurityProfile) Apply(spec *k8s.PodSpec) {
+
+	props.defaultProps()
+
+	spec.SecurityContext = props.podContext()
+
+	containers := []*k8s.Container{}
+	if spec.Containers != nil {
+		containers = append(containers, *spec.Containers...)
+	}
+	if spec.InitContainers != nil {
+		containers = append(containers, *spec.InitContainers...)
+	}
+
+	for _, container := range containers {
+		container.SecurityContext = props.containerContext()
+	}
+
+	for _, writable := range *props.WritablePaths {
+		name := jsii.String(writableVolumeName(*writable))
+		volumes := []*k8s.Volume{}
+		if spec.Volumes != nil {
+			volumes = *spec.Volumes
+		}
+		volumes = append(volumes, &k8s.Volume{
+			Name:     name,
+			EmptyDir: &k8s.EmptyDirVolumeSource{},
+		})
+		spec.Volumes = &volumes
+		for _, container := range containers {
+			mounts := []*k8s.VolumeMount{}
+			if container.VolumeMounts != nil {
+				mounts = *container.VolumeMounts
+			}
+			mounts = append(mounts, &k8s.VolumeMount{
+				MountPath: writable,
+				Name:      name,
+			})
+			container.VolumeMounts = &mounts
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeRecommendedLabels(t *testing.T) {
+
+	app := cdk8s.NewApp(nil)
+
+	KubeConfigure(app, &KubeLabels{
+		PartOf:    jsii.String("shop"),
+		ManagedBy: jsii.String("ci"),
+	})
+
+	chart := cdk8s.NewChart(app, jsii.String("chart"), nil)
+
+	tracker := KubeTrack(chart, "api", jsii.String("api:1.25"), &KubeLabels{
+		Version: jsii.String("1.25.1"),
+	})
+
+	podLabels := map[string]*string{
+		"io.service": jsii.String("api"),
+	}
+
+	tracker.Merge(podLabels)
+
+	k8s.NewKubeDeployment(chart, jsii.String("deployment"), &k8s.KubeDeploymentProps{
+		Spec: &k8s.DeploymentSpec{
+			Selector: &k8s.LabelSelector{
+				MatchLabels: &map[string]*string{
+					"io.service": jsii.String("api"),
+				},
+			},
+			Template: &k8s.PodTemplateSpec{
+				Metadata: &k8s.ObjectMeta{
+					Labels: &podLabels,
+				},
+				Spec: &k8s.PodSpec{
+					Containers: &[]*k8s.Container{
+						{Name: jsii.String("api"), Image: jsii.String("api:1.25")},
+					},
+				},
+			},
+		},
+	})
+
+	tracker.Apply()
+
+	deployment := synth.Find(t, synth.Manifests(app), "Deployment")
+
+	expected := map[string]string{
+		"app.kubernetes.io/name":       "api",
+		"app.kubernetes.io/instance":   "api",
+		"app.kubernetes.io/version":    "1.25.1",
+		"app.kubernetes.io/part-of":    "shop",
+		"app.kubernetes.io/managed-by": "ci",
+	}
+
+	for key, value := range expected {
+		if actual := synth.Field(deployment, "metadata", "labels", key); actual != value {
+			t.Errorf("deployment %s: expected %s, got %v", key, value, actual)
+		}
+		if actual := synth.Field(deployment, "spec", "template", "metadata", "labels", key); actual != value {
+			t.Errorf("pod %s: expected %s, got %v", key, value, actual)
+		}
+	}
+
+	if selector := synth.Field(deployment, "spec", "selector", "matchLabels").(map[string]interface{}); len(selector) != 1 {
+		t.Errorf("expected the selector to keep io.service only, got %v", selector)
+	}
+}
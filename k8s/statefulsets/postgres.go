@@ -5,14 +5,22 @@ import (
 
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/k8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
 	volumes "github.com/erritis/cdk8skit/v4/k8s/volumes"
 )
 
 type KubePostgresResource struct {
-	StatefulSet k8s.KubeStatefulSet
-	Service     k8s.KubeService
-	Props       KubePostgresProps
+	StatefulSet    k8s.KubeStatefulSet
+	Service        k8s.KubeService
+	ClientService  k8s.KubeService
+	ServiceAccount pods.KubeServiceAccountResource
+	Monitor        cdk8s.ApiObject
+	Props          KubePostgresProps
 }
 
 type KubePostgresVolumeSettings struct {
@@ -30,22 +38,44 @@ type KubePostgresDatabase struct {
 }
 
 type KubePostgresPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      *string
+	AppProtocol   *string
 }
 
+// KubePostgresProps defaults Image to postgres:16. Another image is given as a
+// name or as a structured containers.KubeImage rendered with Name.
 type KubePostgresProps struct {
-	Image          *string
-	Database       *KubePostgresDatabase
-	Ports          *KubePostgresPort
-	VolumeSettings *KubePostgresVolumeSettings
-	Network        *string
-	Liveness       *k8s.Probe
+	Image                         *string
+	ImagePullPolicy               *string
+	Command                       *[]*string
+	Args                          *[]*string
+	WorkingDir                    *string
+	Database                      *KubePostgresDatabase
+	Ports                         *KubePostgresPort
+	AdditionalPorts               *[]*KubePostgresPort
+	VolumeSettings                *KubePostgresVolumeSettings
+	Network                       *string
+	Labels                        *recommended.KubeLabels
+	Service                       *containers.KubeServiceOptions
+	Liveness                      *k8s.Probe
+	PostStart                     *k8s.LifecycleHandler
+	PreStop                       *k8s.LifecycleHandler
+	TerminationGracePeriodSeconds *float64
+	TerminationMessagePolicy      *string
+	Resources                     *k8s.ResourceRequirements
+	Scheduling                    *pods.KubeSchedulingProps
+	SecurityProfile               *pods.KubeSecurityProfile
+	ServiceAccount                *pods.KubeServiceAccountProps
+	Metrics                       *monitoring.KubeMetricsProps
 }
 
 func (props *KubePostgresProps) defaultProps(id string) {
 	if props.Image == nil {
-		props.Image = jsii.String("postgres:latest")
+		// Мажорная версия закреплена: с latest обновление образа ломало бы каталог данных
+		props.Image = jsii.String("postgres:16")
 	}
 
 	props.defaultDbProps()
@@ -146,6 +176,22 @@ func NewKubePostgres(
 
 	props.defaultProps(id)
 
+	tracker := recommended.KubeTrack(scope, id, props.Image, props.Labels)
+
+	additionalPorts := []*KubeStatefulSetPort{}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			additionalPorts = append(additionalPorts, &KubeStatefulSetPort{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
+
 	db := volumes.NewKubeSecretVolume(
 		scope, "name-secret",
 		props.VolumeSettings.PrefixSecretName,
@@ -173,10 +219,20 @@ func NewKubePostgres(
 		*props.Image,
 		&KubeStatefulSetProps{
 			Ports: &KubeStatefulSetPort{
+				Name:          props.Ports.Name,
 				Port:          props.Ports.Port,
 				ContainerPort: props.Ports.ContainerPort,
+				Protocol:      props.Ports.Protocol,
+				AppProtocol:   props.Ports.AppProtocol,
 			},
-			Network: props.Network,
+			AdditionalPorts: &additionalPorts,
+			ImagePullPolicy: props.ImagePullPolicy,
+			Command:         props.Command,
+			Args:            props.Args,
+			WorkingDir:      props.WorkingDir,
+			Network:         props.Network,
+			Labels:          props.Labels,
+			Service:         props.Service,
 			Variables: &map[string]*string{
 				"POSTGRES_DB_FILE":       jsii.String(fmt.Sprintf("/run/secrets/%[1]s/%[1]s", *props.VolumeSettings.PrefixSecretName)),
 				"POSTGRES_USER_FILE":     jsii.String(fmt.Sprintf("/run/secrets/%[1]s-user/%[1]s-user", *props.VolumeSettings.PrefixSecretName)),
@@ -190,13 +246,27 @@ func NewKubePostgres(
 				fmt.Sprintf("/run/secrets/%s-user", *props.VolumeSettings.PrefixSecretName):   &dbUser.Volume,
 				fmt.Sprintf("/run/secrets/%s-passwd", *props.VolumeSettings.PrefixSecretName): &dbPasswd.Volume,
 			},
-			Liveness: props.Liveness,
+			Liveness:                      props.Liveness,
+			PostStart:                     props.PostStart,
+			PreStop:                       props.PreStop,
+			TerminationGracePeriodSeconds: props.TerminationGracePeriodSeconds,
+			TerminationMessagePolicy:      props.TerminationMessagePolicy,
+			Resources:                     props.Resources,
+			Scheduling:                    props.Scheduling,
+			SecurityProfile:               props.SecurityProfile,
+			ServiceAccount:                props.ServiceAccount,
+			Metrics:                       props.Metrics,
 		},
 	)
 
+	tracker.Apply()
+
 	return KubePostgresResource{
-		StatefulSet: statefulSetResource.StatefulSet,
-		Service:     statefulSetResource.Service,
-		Props:       *props,
+		StatefulSet:    statefulSetResource.StatefulSet,
+		Service:        statefulSetResource.Service,
+		ClientService:  statefulSetResource.ClientService,
+		ServiceAccount: statefulSetResource.ServiceAccount,
+		Monitor:        statefulSetResource.Monitor,
+		Props:          *props,
 	}
 }
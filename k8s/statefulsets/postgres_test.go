@@ -0,0 +1,20 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubePostgresDefaultImage(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubePostgres(chart, "db", &KubePostgresProps{})
+
+	statefulSet := synth.Find(t, synth.Manifests(app), "StatefulSet")
+
+	if image := synth.Field(statefulSet, "spec", "template", "spec", "containers", 0, "image"); image != "postgres:16" {
+		t.Errorf("expected the pinned postgres:16 image, got %v", image)
+	}
+}
@@ -5,26 +5,65 @@ import (
 
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+	monitoring "github.com/erritis/cdk8skit/v4/k8s/monitoring"
+	pods "github.com/erritis/cdk8skit/v4/k8s/pods"
+	recommended "github.com/erritis/cdk8skit/v4/k8s/recommended"
 )
 
+// KubeStatefulSetResource holds the headless governing Service giving each
+// pod a stable DNS name, and the ClientService created when Service options
+// are set. The governing Service keeps its name but used to have a cluster
+// IP, which cannot be removed in place: delete the old Service before
+// applying.
 type KubeStatefulSetResource struct {
-	StatefulSet k8s.KubeStatefulSet
-	Service     k8s.KubeService
+	StatefulSet    k8s.KubeStatefulSet
+	Service        k8s.KubeService
+	ClientService  k8s.KubeService
+	ServiceAccount pods.KubeServiceAccountResource
+	Monitor        cdk8s.ApiObject
 }
 
 type KubeStatefulSetPort struct {
+	Name          *string
 	Port          *float64
 	ContainerPort *float64
+	Protocol      *string
+	AppProtocol   *string
 }
 
 type KubeStatefulSetProps struct {
-	Ports                *KubeStatefulSetPort
-	Network              *string
-	Variables            *map[string]*string
-	VolumeClaimTemplates *map[string]*k8s.KubePersistentVolumeClaimProps
-	Volumes              *map[string]*k8s.Volume
-	Liveness             *k8s.Probe
+	ImagePullPolicy               *string
+	Command                       *[]*string
+	Args                          *[]*string
+	WorkingDir                    *string
+	Ports                         *KubeStatefulSetPort
+	AdditionalPorts               *[]*KubeStatefulSetPort
+	Network                       *string
+	Labels                        *recommended.KubeLabels
+	Service                       *containers.KubeServiceOptions
+	Variables                     *map[string]*string
+	Environment                   *map[string]*k8s.EnvVarSource
+	EnvFrom                       *[]*k8s.EnvFromSource
+	VolumeClaimTemplates          *map[string]*k8s.KubePersistentVolumeClaimProps
+	Volumes                       *map[string]*k8s.Volume
+	Mounts                        *map[string]*containers.KubeMount
+	Liveness                      *k8s.Probe
+	Readiness                     *k8s.Probe
+	Startup                       *k8s.Probe
+	PostStart                     *k8s.LifecycleHandler
+	PreStop                       *k8s.LifecycleHandler
+	TerminationGracePeriodSeconds *float64
+	TerminationMessagePolicy      *string
+	Resources                     *k8s.ResourceRequirements
+	InitContainers                *[]*containers.KubeContainerProps
+	Sidecars                      *[]*containers.KubeContainerProps
+	Scheduling                    *pods.KubeSchedulingProps
+	SecurityProfile               *pods.KubeSecurityProfile
+	ServiceAccount                *pods.KubeServiceAccountProps
+	Metrics                       *monitoring.KubeMetricsProps
 }
 
 func (props *KubeStatefulSetProps) defaultProps() {
@@ -38,6 +77,36 @@ func (props *KubeStatefulSetProps) defaultProps() {
 	if props.Ports.ContainerPort == nil {
 		props.Ports.ContainerPort = jsii.Number(8080)
 	}
+	if props.Resources == nil {
+		props.Resources = &k8s.ResourceRequirements{}
+	}
+}
+
+func (props *KubeStatefulSetProps) ports() []*containers.KubePort {
+
+	ports := []*containers.KubePort{
+		{
+			Name:          props.Ports.Name,
+			Port:          props.Ports.Port,
+			ContainerPort: props.Ports.ContainerPort,
+			Protocol:      props.Ports.Protocol,
+			AppProtocol:   props.Ports.AppProtocol,
+		},
+	}
+
+	if props.AdditionalPorts != nil {
+		for _, port := range *props.AdditionalPorts {
+			ports = append(ports, &containers.KubePort{
+				Name:          port.Name,
+				Port:          port.Port,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+				AppProtocol:   port.AppProtocol,
+			})
+		}
+	}
+
+	return ports
 }
 
 func NewKubeStatefulSet(
@@ -49,14 +118,34 @@ func NewKubeStatefulSet(
 
 	props.defaultProps()
 
+	tracker := recommended.KubeTrack(scope, id, jsii.String(image), props.Labels)
+
+	ports := props.ports()
+
 	labels := make(map[string]*string)
 
 	labels["io.service"] = jsii.String(id)
 
+	tracker.Merge(labels)
+
 	if props.Network != nil {
 		labels[*props.Network] = jsii.String("true")
 	}
 
+	// Имя сервиса не меняется: serviceName стейтфулсета тоже неизменяем
+	serviceSpec := &k8s.ServiceSpec{
+		Selector: &map[string]*string{
+			"io.service": labels["io.service"],
+		},
+		Ports:     containers.KubeServicePorts(ports),
+		Type:      jsii.String("ClusterIP"),
+		ClusterIp: jsii.String("None"),
+	}
+
+	if props.Service != nil {
+		serviceSpec.PublishNotReadyAddresses = props.Service.PublishNotReadyAddresses
+	}
+
 	service := k8s.NewKubeService(
 		scope,
 		jsii.String("service"),
@@ -64,34 +153,39 @@ func NewKubeStatefulSet(
 			Metadata: &k8s.ObjectMeta{
 				Labels: &labels,
 			},
-			Spec: &k8s.ServiceSpec{
-				Selector: &map[string]*string{
-					"io.service": labels["io.service"],
-				},
-				Ports: &[]*k8s.ServicePort{
-					{
-						Name:       jsii.String(fmt.Sprintf("%d", int(*props.Ports.Port))),
-						Port:       props.Ports.Port,
-						TargetPort: k8s.IntOrString_FromNumber(props.Ports.ContainerPort),
-					},
-				},
-				Type: jsii.String("ClusterIP"),
-			},
+			Spec: serviceSpec,
 		},
 	)
 
-	variables := []*k8s.EnvVar{}
+	var clientService k8s.KubeService
 
-	for k, v := range *props.Variables {
-		variables = append(variables, &k8s.EnvVar{
-			Name:  &k,
-			Value: v,
-		})
+	if props.Service != nil {
+		// Без метки io.service: ServiceMonitor выбирает сервисы по ней и
+		// собирал бы метрики каждого пода дважды
+		clientMetadata := &k8s.ObjectMeta{}
+		clientSpec := &k8s.ServiceSpec{
+			Selector: &map[string]*string{
+				"io.service": labels["io.service"],
+			},
+			Ports: containers.KubeServicePorts(ports),
+		}
+		props.Service.Apply(clientMetadata, clientSpec)
+		clientService = k8s.NewKubeService(
+			scope,
+			jsii.String("client-service"),
+			&k8s.KubeServiceProps{
+				Metadata: clientMetadata,
+				Spec:     clientSpec,
+			},
+		)
 	}
 
+	variables := containers.KubeEnv(props.Variables, props.Environment)
+
 	mounts := []*k8s.VolumeMount{}
 
-	for path, volume := range *props.Volumes {
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volume := (*props.Volumes)[path]
 		mounts = append(mounts, &k8s.VolumeMount{
 			MountPath: &path,
 			Name:      volume.Name,
@@ -100,7 +194,8 @@ func NewKubeStatefulSet(
 
 	var volumeClaimTemplates []*k8s.KubePersistentVolumeClaimProps
 
-	for path, claim := range *props.VolumeClaimTemplates {
+	for _, path := range containers.KubeSortedKeys(*props.VolumeClaimTemplates) {
+		claim := (*props.VolumeClaimTemplates)[path]
 		mounts = append(mounts, &k8s.VolumeMount{
 			MountPath: &path,
 			Name:      claim.Metadata.Name,
@@ -110,8 +205,84 @@ func NewKubeStatefulSet(
 
 	volumes := []*k8s.Volume{}
 
-	for _, volume := range *props.Volumes {
-		volumes = append(volumes, volume)
+	for _, path := range containers.KubeSortedKeys(*props.Volumes) {
+		volumes = append(volumes, (*props.Volumes)[path])
+	}
+
+	subMounts, mountVolumes := containers.KubeMounts(props.Mounts)
+
+	mounts = append(mounts, subMounts...)
+
+	for _, volume := range mountVolumes {
+		if !containsVolume(volumes, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	initContainers, sidecars, sidecarVolumes := containers.KubeContainers(props.InitContainers, props.Sidecars)
+
+	for _, volume := range sidecarVolumes {
+		if !containsVolume(volumes, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	var podInitContainers *[]*k8s.Container
+
+	if len(initContainers) > 0 {
+		podInitContainers = &initContainers
+	}
+
+	podContainers := append([]*k8s.Container{
+		{
+			Name:                     jsii.String(fmt.Sprintf("%s-statefulset-pod", id)),
+			Image:                    jsii.String(image),
+			ImagePullPolicy:          props.ImagePullPolicy,
+			Command:                  props.Command,
+			Args:                     props.Args,
+			WorkingDir:               props.WorkingDir,
+			Resources:                props.Resources,
+			Ports:                    containers.KubeContainerPorts(ports),
+			LivenessProbe:            containers.KubeProbe_WithDefaultPort(props.Liveness, props.Ports.ContainerPort),
+			ReadinessProbe:           containers.KubeProbe_WithDefaultPort(props.Readiness, props.Ports.ContainerPort),
+			StartupProbe:             containers.KubeProbe_WithDefaultPort(props.Startup, props.Ports.ContainerPort),
+			Lifecycle:                containers.KubeLifecycle(props.PostStart, props.PreStop, props.Ports.ContainerPort),
+			TerminationMessagePolicy: props.TerminationMessagePolicy,
+			SecurityContext: &k8s.SecurityContext{
+				RunAsNonRoot: jsii.Bool(false),
+			},
+			Env:          &variables,
+			EnvFrom:      props.EnvFrom,
+			VolumeMounts: &mounts,
+		},
+	}, sidecars...)
+
+	podSpec := &k8s.PodSpec{
+		InitContainers: podInitContainers,
+		Containers:     &podContainers,
+		SecurityContext: &k8s.PodSecurityContext{
+			RunAsNonRoot: jsii.Bool(false),
+		},
+		Volumes:                       &volumes,
+		TerminationGracePeriodSeconds: props.TerminationGracePeriodSeconds,
+	}
+
+	var account pods.KubeServiceAccountResource
+
+	if props.ServiceAccount != nil {
+		account = pods.NewKubeServiceAccount(scope, "service-account", props.ServiceAccount)
+		podSpec.ServiceAccountName = account.ServiceAccount.Name()
+		podSpec.AutomountServiceAccountToken = props.ServiceAccount.AutomountToken
+	}
+
+	if props.Scheduling != nil {
+		props.Scheduling.Apply(podSpec, &map[string]*string{
+			"io.service": labels["io.service"],
+		})
+	}
+
+	if props.SecurityProfile != nil {
+		props.SecurityProfile.Apply(podSpec)
 	}
 
 	statefulset := k8s.NewKubeStatefulSet(
@@ -137,37 +308,40 @@ func NewKubeStatefulSet(
 					Metadata: &k8s.ObjectMeta{
 						Labels: &labels,
 					},
-					Spec: &k8s.PodSpec{
-						Containers: &[]*k8s.Container{
-							{
-								Name:      jsii.String(fmt.Sprintf("%s-statefulset-pod", id)),
-								Image:     jsii.String(image),
-								Resources: &k8s.ResourceRequirements{},
-								Ports: &[]*k8s.ContainerPort{
-									{
-										ContainerPort: props.Ports.ContainerPort,
-									},
-								},
-								LivenessProbe: props.Liveness,
-								SecurityContext: &k8s.SecurityContext{
-									RunAsNonRoot: jsii.Bool(false),
-								},
-								Env:          &variables,
-								VolumeMounts: &mounts,
-							},
-						},
-						SecurityContext: &k8s.PodSecurityContext{
-							RunAsNonRoot: jsii.Bool(false),
-						},
-						Volumes: &volumes,
-					},
+					Spec: podSpec,
 				},
 			},
 		},
 	)
 
+	containers.KubeAttachPullSecrets(statefulset, "/spec/template/spec")
+
+	containers.KubeAttachChecksum(statefulset, "/spec/template/spec")
+
+	var monitor cdk8s.ApiObject
+
+	if props.Metrics != nil {
+		monitor = monitoring.NewKubeMonitor(scope, &map[string]*string{
+			"io.service": labels["io.service"],
+		}, ports[0], props.Metrics)
+	}
+
+	tracker.Apply()
+
 	return KubeStatefulSetResource{
-		StatefulSet: statefulset,
-		Service:     service,
+		StatefulSet:    statefulset,
+		Service:        service,
+		ClientService:  clientService,
+		ServiceAccount: account,
+		Monitor:        monitor,
+	}
+}
+
+func containsVolume(volumes []*k8s.Volume, volume *k8s.Volume) bool {
+	for _, existing := range volumes {
+		if *existing.Name == *volume.Name {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,133 @@
+package cdk8skit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+)
+
+func TestKubeStatefulSetStableOrder(t *testing.T) {
+
+	render := func() string {
+		app, chart := synth.NewChart("")
+
+		variables := map[string]*string{}
+		environment := map[string]*k8s.EnvVarSource{}
+		volumes := map[string]*k8s.Volume{}
+
+		for i := 0; i < 8; i++ {
+			variables[fmt.Sprintf("VARIABLE_%d", i)] = jsii.String("value")
+			environment[fmt.Sprintf("SECRET_%d", i)] = &k8s.EnvVarSource{
+				SecretKeyRef: &k8s.SecretKeySelector{
+					Name: jsii.String("secret"),
+					Key:  jsii.String(fmt.Sprintf("key-%d", i)),
+				},
+			}
+			volumes[fmt.Sprintf("/data/%d", i)] = &k8s.Volume{
+				Name:     jsii.String(fmt.Sprintf("data-%d", i)),
+				EmptyDir: &k8s.EmptyDirVolumeSource{},
+			}
+		}
+
+		NewKubeStatefulSet(chart, "store", "store:1.0", &KubeStatefulSetProps{
+			Variables:            &variables,
+			Environment:          &environment,
+			Volumes:              &volumes,
+			VolumeClaimTemplates: &map[string]*k8s.KubePersistentVolumeClaimProps{},
+		})
+
+		return *app.SynthYaml()
+	}
+
+	first := render()
+
+	for i := 0; i < 5; i++ {
+		if render() != first {
+			t.Fatal("expected every synth to render the same manifests")
+		}
+	}
+}
+
+func TestKubeStatefulSetServices(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	resource := NewKubeStatefulSet(chart, "store", "store:1.0", &KubeStatefulSetProps{
+		Volumes:              &map[string]*k8s.Volume{},
+		VolumeClaimTemplates: &map[string]*k8s.KubePersistentVolumeClaimProps{},
+		Service: &containers.KubeServiceOptions{
+			Type:            jsii.String("LoadBalancer"),
+			SessionAffinity: jsii.String("ClientIP"),
+		},
+	})
+
+	manifests := synth.Manifests(app)
+
+	statefulSet := synth.Find(t, manifests, "StatefulSet")
+
+	if name := synth.Field(statefulSet, "spec", "serviceName"); name != *resource.Service.Name() {
+		t.Errorf("expected the governing Service %s, got %v", *resource.Service.Name(), name)
+	}
+
+	services := map[string]map[string]interface{}{}
+	for _, service := range synth.All(manifests, "Service") {
+		services[synth.Field(service, "metadata", "name").(string)] = service
+	}
+
+	governing := services[*resource.Service.Name()]
+
+	if address := synth.Field(governing, "spec", "clusterIP"); address != "None" {
+		t.Errorf("expected a headless governing Service, got %v", address)
+	}
+
+	client := services[*resource.ClientService.Name()]
+
+	if kind := synth.Field(client, "spec", "type"); kind != "LoadBalancer" {
+		t.Errorf("expected a LoadBalancer client Service, got %v", kind)
+	}
+	if affinity := synth.Field(client, "spec", "sessionAffinity"); affinity != "ClientIP" {
+		t.Errorf("expected the ClientIP affinity, got %v", affinity)
+	}
+	if label := synth.Field(client, "metadata", "labels", "io.service"); label != nil {
+		t.Errorf("expected the client Service to stay out of the ServiceMonitor, got %v", label)
+	}
+}
+
+func TestKubeStatefulSetSidecars(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	NewKubeStatefulSet(chart, "store", "store:1.0", &KubeStatefulSetProps{
+		Volumes:              &map[string]*k8s.Volume{},
+		VolumeClaimTemplates: &map[string]*k8s.KubePersistentVolumeClaimProps{},
+		InitContainers: &[]*containers.KubeContainerProps{
+			{Name: jsii.String("migrate"), Image: jsii.String("store:1.0")},
+		},
+		Sidecars: &[]*containers.KubeContainerProps{
+			{Name: jsii.String("proxy"), Image: jsii.String("envoy:1.30"), Native: jsii.Bool(true)},
+			{Name: jsii.String("logs"), Image: jsii.String("fluent-bit:3.0")},
+		},
+	})
+
+	spec := synth.Field(synth.Find(t, synth.Manifests(app), "StatefulSet"), "spec", "template", "spec")
+
+	if name := synth.Field(spec, "containers", 1, "name"); name != "logs" {
+		t.Errorf("expected the logs sidecar next to the main container, got %v", name)
+	}
+	if name := synth.Field(spec, "initContainers", 0, "name"); name != "proxy" {
+		t.Errorf("expected the native proxy first, got %v", name)
+	}
+	if policy := synth.Field(spec, "initContainers", 0, "restartPolicy"); policy != "Always" {
+		t.Errorf("expected a native sidecar, got restart policy %v", policy)
+	}
+	if name := synth.Field(spec, "initContainers", 1, "name"); name != "migrate" {
+		t.Errorf("expected the migrate init container after the proxy, got %v", name)
+	}
+	if policy := synth.Field(spec, "initContainers", 1, "restartPolicy"); policy != nil {
+		t.Errorf("expected a regular init container, got restart policy %v", policy)
+	}
+}
@@ -5,8 +5,8 @@ import (
 
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
-	"github.com/cdk8s-team/cdk8s-core-go/cdk8s/v2"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	namespaces "github.com/erritis/cdk8skit/v4/k8s/namespaces"
 )
 
 type HostStorageProps struct {
@@ -34,7 +34,7 @@ func NewHostStorage(scope constructs.Construct, id string, props *HostStoragePro
 		VolumeBindingMode: jsii.String("Immediate"),
 	})
 
-	storage.AddJsonPatch(cdk8s.JsonPatch_Replace(jsii.String("/metadata/namespace"), new(*string)))
+	namespaces.KubeKeepClusterScoped(scope)
 
 	return storage
 }
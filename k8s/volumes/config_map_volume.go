@@ -0,0 +1,185 @@
+package cdk8skit
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
+)
+
+type KubeConfigMapVolumeResource struct {
+	Volume    k8s.Volume
+	ConfigMap k8s.KubeConfigMap
+	items     map[string]*string
+}
+
+type KubeConfigMapItem struct {
+	Path *string
+	Mode *float64
+}
+
+// KubeConfigMapVolumeProps holds the ConfigMap keys. BinaryData values are
+// base64 encoded. Items limits the volume to the listed keys. Checksum, true
+// by default, rolls out the pods using the ConfigMap when its content changes.
+type KubeConfigMapVolumeProps struct {
+	Name        *string
+	Data        *map[string]*string
+	BinaryData  *map[string]*string
+	Items       *map[string]*KubeConfigMapItem
+	DefaultMode *float64
+	Immutable   *bool
+	Checksum    *bool
+}
+
+func (props *KubeConfigMapVolumeProps) defaultProps(id string) {
+	if props.Name == nil {
+		props.Name = jsii.String(id)
+	}
+	if props.Data == nil {
+		props.Data = &map[string]*string{}
+	}
+	if props.BinaryData == nil {
+		props.BinaryData = &map[string]*string{}
+	}
+	if props.Immutable == nil {
+		props.Immutable = jsii.Bool(false)
+	}
+	if props.Checksum == nil {
+		props.Checksum = jsii.Bool(true)
+	}
+}
+
+func NewKubeConfigMapVolume(scope constructs.Construct, id string, props *KubeConfigMapVolumeProps) KubeConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	var data *map[string]*string
+	if len(*props.Data) > 0 {
+		data = props.Data
+	}
+
+	var binaryData *map[string]*string
+	if len(*props.BinaryData) > 0 {
+		binaryData = props.BinaryData
+	}
+
+	configMap := k8s.NewKubeConfigMap(scope, &id, &k8s.KubeConfigMapProps{
+		Data:       data,
+		BinaryData: binaryData,
+		Immutable:  props.Immutable,
+	})
+
+	if !*props.Checksum {
+		containers.KubeSkipChecksum(configMap)
+	}
+
+	var items *[]*k8s.KeyToPath
+
+	paths := map[string]*string{}
+
+	if props.Items != nil {
+		keys := []string{}
+		for key := range *props.Items {
+			keys = append(keys, key)
+		}
+		// Порядок обхода словаря случаен, а манифест должен быть стабильным
+		sort.Strings(keys)
+
+		items = &[]*k8s.KeyToPath{}
+		for _, key := range keys {
+			item := (*props.Items)[key]
+			path := item.Path
+			if path == nil {
+				path = jsii.String(key)
+			}
+			*items = append(*items, &k8s.KeyToPath{
+				Key:  jsii.String(key),
+				Path: path,
+				Mode: item.Mode,
+			})
+			paths[key] = path
+		}
+	}
+
+	volume := k8s.Volume{
+		Name: props.Name,
+		ConfigMap: &k8s.ConfigMapVolumeSource{
+			Name:        configMap.Name(),
+			Items:       items,
+			DefaultMode: props.DefaultMode,
+		},
+	}
+
+	return KubeConfigMapVolumeResource{
+		Volume:    volume,
+		ConfigMap: configMap,
+		items:     paths,
+	}
+}
+
+// File mounts the single key of the ConfigMap through a sub path, so the
+// rest of the target directory stays intact.
+func (resource KubeConfigMapVolumeResource) File(key string) *containers.KubeMount {
+
+	path, ok := resource.items[key]
+	if !ok {
+		path = jsii.String(key)
+	}
+
+	return &containers.KubeMount{
+		Volume:   &resource.Volume,
+		SubPath:  path,
+		ReadOnly: jsii.Bool(true),
+	}
+}
+
+func addFileData(props *KubeConfigMapVolumeProps, key string, filename string) {
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при чтении файла: %s", err))
+	}
+
+	if utf8.Valid(content) {
+		(*props.Data)[key] = jsii.String(string(content))
+	} else {
+		(*props.BinaryData)[key] = jsii.String(base64.StdEncoding.EncodeToString(content))
+	}
+}
+
+func KubeConfigMapVolume_FromFile(scope constructs.Construct, id string, path *string, props *KubeConfigMapVolumeProps) KubeConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	addFileData(props, filepath.Base(*path), *path)
+
+	return NewKubeConfigMapVolume(scope, id, props)
+}
+
+// KubeConfigMapVolume_FromDirectory stores every regular file of the
+// directory under its file name. Subdirectories are skipped.
+func KubeConfigMapVolume_FromDirectory(scope constructs.Construct, id string, dir *string, props *KubeConfigMapVolumeProps) KubeConfigMapVolumeResource {
+
+	props.defaultProps(id)
+
+	entries, err := os.ReadDir(*dir)
+	if err != nil {
+		panic(fmt.Sprintf("Ошибка при чтении каталога: %s", err))
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		addFileData(props, entry.Name(), filepath.Join(*dir, entry.Name()))
+	}
+
+	return NewKubeConfigMapVolume(scope, id, props)
+}
@@ -0,0 +1,43 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeConfigMapVolumeItemsOrder(t *testing.T) {
+
+	_, chart := synth.NewChart("")
+
+	resource := NewKubeConfigMapVolume(chart, "config", &KubeConfigMapVolumeProps{
+		Data: &map[string]*string{
+			"c.conf": jsii.String("c"),
+			"a.conf": jsii.String("a"),
+			"b.conf": jsii.String("b"),
+		},
+		Items: &map[string]*KubeConfigMapItem{
+			"c.conf": {},
+			"a.conf": {Path: jsii.String("conf/a.conf")},
+			"b.conf": {},
+		},
+	})
+
+	expected := []string{"a.conf", "b.conf", "c.conf"}
+
+	items := *resource.Volume.ConfigMap.Items
+	if len(items) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(items))
+	}
+
+	for i, key := range expected {
+		if *items[i].Key != key {
+			t.Errorf("item %d: expected %s, got %s", i, key, *items[i].Key)
+		}
+	}
+
+	if *items[0].Path != "conf/a.conf" {
+		t.Errorf("expected the conf/a.conf path, got %s", *items[0].Path)
+	}
+}
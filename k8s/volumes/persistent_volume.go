@@ -4,6 +4,7 @@ import (
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	namespaces "github.com/erritis/cdk8skit/v4/k8s/namespaces"
 )
 
 type KubePersistentVolumeProps struct {
@@ -44,5 +45,7 @@ func NewKubePersistentVolume(scope constructs.Construct, id string, claim_name *
 		},
 	)
 
+	namespaces.KubeKeepClusterScoped(scope)
+
 	return volume
 }
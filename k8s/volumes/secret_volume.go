@@ -1,11 +1,10 @@
 package cdk8skit
 
 import (
-	"fmt"
-
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/cdk8s-team/cdk8s-plus-go/cdk8splus28/v2/k8s"
+	containers "github.com/erritis/cdk8skit/v4/k8s/containers"
 )
 
 type KubeSecretVolumeResource struct {
@@ -13,14 +12,20 @@ type KubeSecretVolumeResource struct {
 	Secret k8s.KubeSecret
 }
 
+// KubeSecretVolumeProps describes the secret. Checksum, true by default, rolls
+// out the pods mounting the volume when the value changes.
 type KubeSecretVolumeProps struct {
-	Encrypt *bool
+	Encrypt  *bool
+	Checksum *bool
 }
 
 func (props *KubeSecretVolumeProps) defaultProps() {
 	if props.Encrypt == nil {
 		props.Encrypt = jsii.Bool(true)
 	}
+	if props.Checksum == nil {
+		props.Checksum = jsii.Bool(true)
+	}
 }
 
 func NewKubeSecretVolume(scope constructs.Construct, id string, name *string, value *string, props *KubeSecretVolumeProps) KubeSecretVolumeResource {
@@ -50,10 +55,14 @@ func NewKubeSecretVolume(scope constructs.Construct, id string, name *string, va
 		Immutable:  jsii.Bool(false),
 	})
 
+	if !*props.Checksum {
+		containers.KubeSkipChecksum(secret)
+	}
+
 	volume := k8s.Volume{
 		Name: name,
 		Secret: &k8s.SecretVolumeSource{
-			SecretName: jsii.String(fmt.Sprintf("%s-%s", *scope.Node().Id(), id)),
+			SecretName: secret.Name(),
 			Items: &[]*k8s.KeyToPath{
 				{
 					Key:  name,
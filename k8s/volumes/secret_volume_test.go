@@ -0,0 +1,21 @@
+package cdk8skit
+
+import (
+	"testing"
+
+	"github.com/aws/jsii-runtime-go"
+	synth "github.com/erritis/cdk8skit/v4/internal/synth"
+)
+
+func TestKubeSecretVolumeName(t *testing.T) {
+
+	app, chart := synth.NewChart("")
+
+	resource := NewKubeSecretVolume(chart, "token", jsii.String("token"), jsii.String("secret"), &KubeSecretVolumeProps{})
+
+	secret := synth.Find(t, synth.Manifests(app), "Secret")
+
+	if name := synth.Field(secret, "metadata", "name"); name != *resource.Volume.Secret.SecretName {
+		t.Errorf("expected the volume to use the secret %v, got %s", name, *resource.Volume.Secret.SecretName)
+	}
+}